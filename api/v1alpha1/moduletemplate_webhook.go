@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	"github.com/Masterminds/semver/v3"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -35,15 +36,17 @@ import (
 var moduletemplatelog = logf.Log.WithName("moduletemplate-resource") //nolint:gochecknoglobals
 
 func (in *ModuleTemplate) SetupWebhookWithManager(
-	mgr ctrl.Manager,
+	mgr ctrl.Manager, descriptorValidations ...v1beta1.DescriptorValidation,
 ) error {
 	return ctrl.NewWebhookManagedBy(mgr).WithValidator(&clusterAwareModuleTemplateValidator{
-		Client: mgr.GetClient(),
+		Client:                mgr.GetClient(),
+		DescriptorValidations: descriptorValidations,
 	}).For(in).Complete()
 }
 
 type clusterAwareModuleTemplateValidator struct {
-	Client client.Client
+	Client                client.Client
+	DescriptorValidations []v1beta1.DescriptorValidation
 }
 
 // TODO(user): EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
@@ -83,7 +86,7 @@ func (r *clusterAwareModuleTemplateValidator) ValidateDelete(ctx context.Context
 }
 
 func (r *clusterAwareModuleTemplateValidator) validate(
-	_ context.Context, oldTemplate, newTemplate *ModuleTemplate,
+	ctx context.Context, oldTemplate, newTemplate *ModuleTemplate,
 ) error {
 	newDescriptor, err := newTemplate.Spec.GetDescriptor()
 	if err != nil {
@@ -119,5 +122,14 @@ func (r *clusterAwareModuleTemplateValidator) validate(
 		}
 	}
 
+	hub := &v1beta1.ModuleTemplate{}
+	if err := newTemplate.ConvertTo(hub); err != nil {
+		return err
+	}
+	if errs := v1beta1.ValidateModuleTemplate(ctx, hub, newDescriptor, r.DescriptorValidations); len(errs) > 0 {
+		return apierrors.NewInvalid(
+			schema.GroupKind{Group: GroupVersion.Group, Kind: "ModuleTemplate"}, newTemplate.Name, errs)
+	}
+
 	return nil
 }
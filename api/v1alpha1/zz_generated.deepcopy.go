@@ -254,6 +254,11 @@ func (in *ManifestStatus) DeepCopyInto(out *ManifestStatus) {
 		*out = make([]v2.Resource, len(*in))
 		copy(*out, *in)
 	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(v2.Plan)
+		(*in).DeepCopyInto(*out)
+	}
 	in.LastOperation.DeepCopyInto(&out.LastOperation)
 }
 
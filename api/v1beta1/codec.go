@@ -27,7 +27,7 @@ type ImageSpec struct {
 	// Type specifies the type of installation specification
 	// that could be provided as part of a custom resource.
 	// This time is used in codec to successfully decode from raw extensions.
-	// +kubebuilder:validation:Enum=helm-chart;oci-ref;"kustomize";""
+	// +kubebuilder:validation:Enum=helm-chart;oci-ref;oci-artifact;ctf-ref;"kustomize";""
 	Type RefTypeMetadata `json:"type,omitempty"`
 
 	// CredSecretSelector is an optional field, for OCI image saved in private registry,
@@ -75,6 +75,12 @@ func (t RefTypeMetadata) NotEmpty() bool {
 const (
 	HelmChartType RefTypeMetadata = "helm-chart"
 	OciRefType    RefTypeMetadata = "oci-ref"
+	// OciArtifactType refers to an OCI artifact by a tag or digest, e.g. a helm chart pushed to a registry,
+	// whose content is stored in the only layer of the artifact.
+	OciArtifactType RefTypeMetadata = "oci-artifact"
+	// CTFRefType refers to a blob by its digest in a common transport format archive on the filesystem,
+	// which is used for installations without access to a registry.
+	CTFRefType    RefTypeMetadata = "ctf-ref"
 	KustomizeType RefTypeMetadata = "kustomize"
 	NilRefType    RefTypeMetadata = ""
 )
@@ -166,7 +172,7 @@ func (c *Codec) Validate(data []byte, refType RefTypeMetadata) error {
 		if err != nil {
 			return err
 		}
-	case OciRefType:
+	case OciRefType, OciArtifactType, CTFRefType:
 		result, err = c.imageSpecSchema.Validate(dataBytes)
 		if err != nil {
 			return err
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 
 	v2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1/codec/v3alpha1"
 )
 
 // DecodeV2 decodes a component into the given object.
@@ -31,3 +33,22 @@ func EncodeV2(obj *v2.ComponentDescriptor) ([]byte, error) {
 	}
 	return json.Marshal(obj)
 }
+
+// DecodeV3 decodes a component in the v3alpha1 schema and normalises it into the given v2 object.
+// Like DecodeV2, it does not validate the component.
+func DecodeV3(data []byte, obj *v2.ComponentDescriptor) error {
+	var descriptor v3alpha1.ComponentDescriptor
+	if err := json.Unmarshal(data, &descriptor); err != nil {
+		return err
+	}
+	return v3alpha1.ConvertToV2(&descriptor, obj)
+}
+
+// EncodeV3 encodes a normalised component in the v3alpha1 schema.
+func EncodeV3(obj *v2.ComponentDescriptor) ([]byte, error) {
+	descriptor, err := v3alpha1.ConvertFromV2(obj)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(descriptor)
+}
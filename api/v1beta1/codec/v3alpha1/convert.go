@@ -0,0 +1,183 @@
+package v3alpha1
+
+import (
+	"encoding/json"
+	"strings"
+
+	v2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+)
+
+// ociRegistryTypeVersioned is the versioned variant of OCIRegistryType that is accepted as well.
+const ociRegistryTypeVersioned = OCIRegistryType + "/v1"
+
+// IsV3 determines from the apiVersion of the raw descriptor if it is in the v3alpha1 schema.
+// Descriptors in the v2 schema do not carry an apiVersion.
+func IsV3(data []byte) bool {
+	var header struct {
+		APIVersion string `json:"apiVersion"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return false
+	}
+	return header.APIVersion == SchemaVersion
+}
+
+// IsNormalised determines if the descriptor was normalised from the v3alpha1 schema.
+func IsNormalised(descriptor *v2.ComponentDescriptor) bool {
+	return descriptor.Metadata.Version == SchemaVersion
+}
+
+// ConvertToV2 normalises the descriptor into the v2 model. Repository contexts and access types that have a v2
+// counterpart are translated, all others are kept as they are. The schema version of the normalised descriptor
+// stays SchemaVersion, so that it can be encoded in its original schema again.
+func ConvertToV2(in *ComponentDescriptor, out *v2.ComponentDescriptor) error {
+	contexts := make([]*v2.UnstructuredTypedObject, 0, len(in.RepositoryContexts))
+	for _, repositoryContext := range in.RepositoryContexts {
+		converted, err := repositoryContextToV2(repositoryContext)
+		if err != nil {
+			return err
+		}
+		contexts = append(contexts, converted)
+	}
+
+	resources := make([]v2.Resource, 0, len(in.Spec.Resources))
+	for _, resource := range in.Spec.Resources {
+		access, err := accessToV2(resource.Access)
+		if err != nil {
+			return err
+		}
+		resources = append(resources, v2.Resource{
+			IdentityObjectMeta: resource.IdentityObjectMeta,
+			Digest:             resource.Digest,
+			Relation:           resource.Relation,
+			SourceRef:          resource.SourceRefs,
+			Access:             access,
+		})
+	}
+
+	*out = v2.ComponentDescriptor{
+		Metadata: v2.Metadata{Version: SchemaVersion},
+		ComponentSpec: v2.ComponentSpec{
+			ObjectMeta: v2.ObjectMeta{
+				Name:    in.Metadata.Name,
+				Version: in.Metadata.Version,
+				Labels:  in.Metadata.Labels,
+			},
+			RepositoryContexts:  contexts,
+			Provider:            v2.ProviderType(in.Metadata.Provider.Name),
+			Sources:             in.Spec.Sources,
+			ComponentReferences: in.Spec.References,
+			Resources:           resources,
+		},
+		Signatures: in.Signatures,
+	}
+	return v2.DefaultComponent(out)
+}
+
+// ConvertFromV2 translates a normalised descriptor back into the v3alpha1 schema.
+func ConvertFromV2(in *v2.ComponentDescriptor) (*ComponentDescriptor, error) {
+	contexts := make([]*v2.UnstructuredTypedObject, 0, len(in.RepositoryContexts))
+	for _, repositoryContext := range in.RepositoryContexts {
+		converted := repositoryContext
+		if repositoryContext.GetType() == v2.OCIRegistryType {
+			var err error
+			if converted, err = retype(repositoryContext, OCIRegistryType, nil); err != nil {
+				return nil, err
+			}
+		}
+		contexts = append(contexts, converted)
+	}
+
+	resources := make([]Resource, 0, len(in.Resources))
+	for _, resource := range in.Resources {
+		access := resource.Access
+		if access != nil && access.GetType() == v2.LocalOCIBlobType {
+			var err error
+			if access, err = retype(access, LocalBlobType, map[string]string{"digest": "localReference"}); err != nil {
+				return nil, err
+			}
+		}
+		resources = append(resources, Resource{
+			IdentityObjectMeta: resource.IdentityObjectMeta,
+			Digest:             resource.Digest,
+			Relation:           resource.Relation,
+			SourceRefs:         resource.SourceRef,
+			Access:             access,
+		})
+	}
+
+	return &ComponentDescriptor{
+		APIVersion: SchemaVersion,
+		Kind:       Kind,
+		Metadata: ObjectMeta{
+			Name:     in.GetName(),
+			Version:  in.GetVersion(),
+			Labels:   in.Labels,
+			Provider: Provider{Name: string(in.Provider)},
+		},
+		RepositoryContexts: contexts,
+		Spec: ComponentVersionSpec{
+			Sources:    in.Sources,
+			References: in.ComponentReferences,
+			Resources:  resources,
+		},
+		Signatures: in.Signatures,
+	}, nil
+}
+
+// repositoryContextToV2 translates OCI registries, whose optional subPath is part of the base URL in v2.
+func repositoryContextToV2(repositoryContext *v2.UnstructuredTypedObject) (*v2.UnstructuredTypedObject, error) {
+	if repositoryContext == nil {
+		return nil, nil
+	}
+	if ttype := repositoryContext.GetType(); ttype != OCIRegistryType && ttype != ociRegistryTypeVersioned {
+		return repositoryContext, nil
+	}
+	attributes := make(map[string]interface{}, len(repositoryContext.Object))
+	for key, value := range repositoryContext.Object {
+		attributes[key] = value
+	}
+	if subPath, ok := attributes["subPath"].(string); ok {
+		if baseURL, ok := attributes["baseUrl"].(string); ok && subPath != "" {
+			attributes["baseUrl"] = strings.TrimSuffix(baseURL, "/") + "/" + strings.TrimPrefix(subPath, "/")
+		}
+		delete(attributes, "subPath")
+	}
+	attributes["type"] = v2.OCIRegistryType
+	return newTypedObject(attributes)
+}
+
+func accessToV2(access *v2.UnstructuredTypedObject) (*v2.UnstructuredTypedObject, error) {
+	if access == nil || access.GetType() != LocalBlobType {
+		return access, nil
+	}
+	return retype(access, v2.LocalOCIBlobType, map[string]string{"localReference": "digest"})
+}
+
+// retype copies the typed object with a new type and renamed attributes.
+func retype(
+	object *v2.UnstructuredTypedObject, ttype string, renames map[string]string,
+) (*v2.UnstructuredTypedObject, error) {
+	attributes := make(map[string]interface{}, len(object.Object))
+	for key, value := range object.Object {
+		if renamed, ok := renames[key]; ok {
+			key = renamed
+		}
+		attributes[key] = value
+	}
+	attributes["type"] = ttype
+	return newTypedObject(attributes)
+}
+
+// newTypedObject encodes the attributes, so that the raw and structured representation of the object match.
+func newTypedObject(attributes map[string]interface{}) (*v2.UnstructuredTypedObject, error) {
+	data, err := json.Marshal(attributes)
+	if err != nil {
+		return nil, err
+	}
+	object := &v2.UnstructuredTypedObject{}
+	if err := object.UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+	return object, nil
+}
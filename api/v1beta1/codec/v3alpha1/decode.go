@@ -0,0 +1,49 @@
+package v3alpha1
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	v2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"github.com/gardener/component-spec/bindings-go/apis/v2/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var ErrInvalidDescriptor = errors.New("invalid v3alpha1 component descriptor")
+
+// Decode decodes and validates a descriptor in the v3alpha1 schema and normalises it into the v2 model.
+// Contrary to the v2 schema, the provider is a free-form name and not restricted to internal or external.
+func Decode(data []byte, obj *v2.ComponentDescriptor) error {
+	var descriptor ComponentDescriptor
+	if err := json.Unmarshal(data, &descriptor); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidDescriptor, err.Error())
+	}
+	if err := ConvertToV2(&descriptor, obj); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidDescriptor, err.Error())
+	}
+	if errs := validate(&descriptor, obj); len(errs) > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidDescriptor, errs.ToAggregate().Error())
+	}
+	return nil
+}
+
+func validate(descriptor *ComponentDescriptor, normalised *v2.ComponentDescriptor) field.ErrorList {
+	var errs field.ErrorList
+	if descriptor.Kind != Kind {
+		errs = append(errs, field.NotSupported(field.NewPath("kind"), descriptor.Kind, []string{Kind}))
+	}
+	metadataPath := field.NewPath("metadata")
+	if descriptor.Metadata.Provider.Name == "" {
+		errs = append(errs, field.Required(metadataPath.Child("provider", "name"), "provider must be set"))
+	}
+	errs = append(errs, validation.ValidateObjectMeta(metadataPath, normalised)...)
+
+	specPath := field.NewPath("spec")
+	errs = append(errs, validation.ValidateSources(specPath.Child("sources"), normalised.Sources)...)
+	errs = append(errs, validation.ValidateComponentReferences(
+		specPath.Child("references"), normalised.ComponentReferences)...)
+	errs = append(errs, validation.ValidateResources(
+		specPath.Child("resources"), normalised.Resources, normalised.GetVersion())...)
+	return errs
+}
@@ -0,0 +1,107 @@
+package v3alpha1
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// JSONNormalisationV2 is the normalisation algorithm of the digests of descriptors in the v3alpha1 schema.
+const JSONNormalisationV2 = "jsonNormalisation/v2"
+
+var ErrNormalisationAlgorithm = errors.New("normalisation algorithm is not supported")
+
+// Normalise returns the form of a descriptor in the v3alpha1 schema that its digest is calculated over.
+// Everything that may change without changing the component version is excluded: the signatures,
+// repository contexts, creation time, the access of resources and sources, source references and labels
+// that are not marked for signing. Resources without access are excluded entirely. The remaining document
+// is encoded as compact JSON with sorted keys.
+func Normalise(data []byte, algorithm string) ([]byte, error) {
+	if algorithm != JSONNormalisationV2 {
+		return nil, fmt.Errorf("%w: %s", ErrNormalisationAlgorithm, algorithm)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var descriptor map[string]interface{}
+	if err := decoder.Decode(&descriptor); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDescriptor, err.Error())
+	}
+
+	delete(descriptor, "signatures")
+	delete(descriptor, "repositoryContexts")
+	if metadata, ok := descriptor["metadata"].(map[string]interface{}); ok {
+		delete(metadata, "creationTime")
+		excludeLabels(metadata)
+	}
+	if spec, ok := descriptor["spec"].(map[string]interface{}); ok {
+		if resources, ok := spec["resources"].([]interface{}); ok {
+			spec["resources"] = normaliseArtifacts(resources, true, "access", "srcRefs")
+		}
+		if sources, ok := spec["sources"].([]interface{}); ok {
+			spec["sources"] = normaliseArtifacts(sources, false, "access")
+		}
+		if references, ok := spec["references"].([]interface{}); ok {
+			spec["references"] = normaliseArtifacts(references, false)
+		}
+	}
+
+	buffer := &bytes.Buffer{}
+	encoder := json.NewEncoder(buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(descriptor); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buffer.Bytes(), []byte("\n")), nil
+}
+
+// normaliseArtifacts removes the excluded attributes and unsigned labels of the artifacts,
+// and artifacts without access if skipWithoutAccess is set.
+func normaliseArtifacts(artifacts []interface{}, skipWithoutAccess bool, excluded ...string) []interface{} {
+	normalised := make([]interface{}, 0, len(artifacts))
+	for _, artifact := range artifacts {
+		attributes, ok := artifact.(map[string]interface{})
+		if !ok {
+			normalised = append(normalised, artifact)
+			continue
+		}
+		if skipWithoutAccess && !hasAccess(attributes) {
+			continue
+		}
+		for _, name := range excluded {
+			delete(attributes, name)
+		}
+		excludeLabels(attributes)
+		normalised = append(normalised, attributes)
+	}
+	return normalised
+}
+
+func hasAccess(artifact map[string]interface{}) bool {
+	access, ok := artifact["access"].(map[string]interface{})
+	if !ok {
+		return false
+	}
+	accessType, _ := access["type"].(string)
+	return !strings.EqualFold(accessType, "none")
+}
+
+// excludeLabels keeps only the labels of the object that are marked for signing.
+func excludeLabels(object map[string]interface{}) {
+	labels, ok := object["labels"].([]interface{})
+	if !ok {
+		return
+	}
+	signed := make([]interface{}, 0, len(labels))
+	for _, label := range labels {
+		if attributes, ok := label.(map[string]interface{}); ok && attributes["signing"] == true {
+			signed = append(signed, label)
+		}
+	}
+	if len(signed) == 0 {
+		delete(object, "labels")
+		return
+	}
+	object["labels"] = signed
+}
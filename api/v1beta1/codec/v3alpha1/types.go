@@ -0,0 +1,64 @@
+// Package v3alpha1 supports component descriptors in the ocm.software/v3alpha1 schema of the
+// Open Component Model. Descriptors are normalised into the gardener v2 model on decoding,
+// so that everything working on descriptors stays independent of the schema they were published in.
+package v3alpha1
+
+import (
+	v2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+)
+
+const (
+	// SchemaVersion is the apiVersion of component descriptors in the v3alpha1 schema.
+	// It is kept as schema version in the metadata of normalised descriptors.
+	SchemaVersion = "ocm.software/v3alpha1"
+	// Kind is the kind of component descriptors in the v3alpha1 schema.
+	Kind = "ComponentVersion"
+
+	// OCIRegistryType is the repository context type of an OCI registry in the v3alpha1 schema.
+	OCIRegistryType = "OCIRegistry"
+	// LocalBlobType is the access type of a blob stored alongside the component descriptor in the v3alpha1 schema.
+	LocalBlobType = "localBlob"
+)
+
+// ComponentDescriptor is a component version in the v3alpha1 schema.
+type ComponentDescriptor struct {
+	APIVersion string     `json:"apiVersion"`
+	Kind       string     `json:"kind"`
+	Metadata   ObjectMeta `json:"metadata"`
+	// RepositoryContexts defines the previous repositories of the component.
+	RepositoryContexts []*v2.UnstructuredTypedObject `json:"repositoryContexts,omitempty"`
+	Spec               ComponentVersionSpec          `json:"spec"`
+	// Signatures are shared with the v2 schema, only the normalisation of the digest differs.
+	Signatures []v2.Signature `json:"signatures,omitempty"`
+}
+
+// ObjectMeta identifies the component version.
+type ObjectMeta struct {
+	Name         string    `json:"name"`
+	Version      string    `json:"version"`
+	Labels       v2.Labels `json:"labels,omitempty"`
+	Provider     Provider  `json:"provider"`
+	CreationTime string    `json:"creationTime,omitempty"`
+}
+
+// Provider describes the provider of the component, which is a free-form name in the v3alpha1 schema.
+type Provider struct {
+	Name   string    `json:"name"`
+	Labels v2.Labels `json:"labels,omitempty"`
+}
+
+// ComponentVersionSpec contains the artifacts of the component version.
+type ComponentVersionSpec struct {
+	Sources    []v2.Source             `json:"sources,omitempty"`
+	References []v2.ComponentReference `json:"references,omitempty"`
+	Resources  []Resource              `json:"resources,omitempty"`
+}
+
+// Resource is a resource of the component version. It differs from the v2 schema only in its source references.
+type Resource struct {
+	v2.IdentityObjectMeta `json:",inline"`
+	Digest                *v2.DigestSpec              `json:"digest,omitempty"`
+	Relation              v2.ResourceRelation         `json:"relation,omitempty"`
+	SourceRefs            []v2.SourceRef              `json:"srcRefs,omitempty"`
+	Access                *v2.UnstructuredTypedObject `json:"access"`
+}
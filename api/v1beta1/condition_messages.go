@@ -17,13 +17,35 @@ const (
 	MessageModuleCatalogIsOutOfSync = "module catalog is out of sync and needs to be resynchronized"
 	MessageSKRWebhookIsSynced       = "skrwebhook is synchronized"
 	MessageSKRWebhookIsOutOfSync    = "skrwebhook is out of sync and needs to be resynchronized"
+	MessageModuleVersionNotFound    = "no module template matches the requested module version"
+	MessageRemoteKymaIsSynced       = "remote kyma is synchronized"
+	MessageRemoteKymaIsOutOfSync    = "remote kyma could not be synchronized"
+	MessageKymaIsReady              = "all subsystems of the kyma are ready"
+	MessageKymaIsNotReady           = "not all subsystems of the kyma are ready"
+	MessageNoModuleDowngrade        = "no module is downgraded"
+	MessageModuleDowngradeBlocked   = "module downgrade was blocked, annotate the kyma to allow the downgrade"
+	MessageReconciliationPaused     = "reconciliation is paused"
+	MessageReconciliationResumed    = "reconciliation is resumed"
+	MessageModuleDeprecated         = "a module is deprecated"
+	MessageNoModuleDeprecated       = "no module is deprecated"
+	MessageModuleRemoved            = "a module was removed after its deprecation"
+	MessageModuleUnverified         = "a module is installed although its signature could not be verified"
+	MessageModulesVerified          = "the signatures of all modules are verified"
 )
 
 // Extend this list by actual needs.
 const (
-	ConditionReasonModulesAreReady      KymaConditionReason = "ModulesAreReady"
-	ConditionReasonModuleCatalogIsReady KymaConditionReason = "ModuleCatalogIsReady"
-	ConditionReasonSKRWebhookIsReady    KymaConditionReason = "SKRWebhookIsReady"
+	ConditionReasonModulesAreReady       KymaConditionReason = "ModulesAreReady"
+	ConditionReasonModuleCatalogIsReady  KymaConditionReason = "ModuleCatalogIsReady"
+	ConditionReasonSKRWebhookIsReady     KymaConditionReason = "SKRWebhookIsReady"
+	ConditionReasonModuleVersionNotFound KymaConditionReason = "ModuleVersionNotFound"
+	ConditionReasonRemoteKymaIsSynced    KymaConditionReason = "RemoteKymaIsSynced"
+	ConditionReasonKymaIsReady           KymaConditionReason = "KymaIsReady"
+	ConditionReasonModuleDowngrade       KymaConditionReason = "ModuleDowngrade"
+	ConditionReasonReconciliationPaused  KymaConditionReason = "ReconciliationPaused"
+	ConditionReasonModuleDeprecated      KymaConditionReason = "ModuleDeprecated"
+	ConditionReasonModuleRemoved         KymaConditionReason = "ModuleRemoved"
+	ConditionReasonModulesVerified       KymaConditionReason = "ModulesVerified"
 )
 
 func GenerateMessage(reason KymaConditionReason, status metav1.ConditionStatus) string {
@@ -55,6 +77,64 @@ func GenerateMessage(reason KymaConditionReason, status metav1.ConditionStatus)
 		}
 
 		return MessageSKRWebhookIsOutOfSync
+	case ConditionReasonModuleVersionNotFound:
+		return MessageModuleVersionNotFound
+	case ConditionReasonRemoteKymaIsSynced:
+		switch status {
+		case metav1.ConditionTrue:
+			return MessageRemoteKymaIsSynced
+		case metav1.ConditionUnknown:
+		case metav1.ConditionFalse:
+		}
+
+		return MessageRemoteKymaIsOutOfSync
+	case ConditionReasonModuleDowngrade:
+		switch status {
+		case metav1.ConditionTrue:
+			return MessageNoModuleDowngrade
+		case metav1.ConditionUnknown:
+		case metav1.ConditionFalse:
+		}
+
+		return MessageModuleDowngradeBlocked
+	case ConditionReasonKymaIsReady:
+		switch status {
+		case metav1.ConditionTrue:
+			return MessageKymaIsReady
+		case metav1.ConditionUnknown:
+		case metav1.ConditionFalse:
+		}
+
+		return MessageKymaIsNotReady
+	case ConditionReasonReconciliationPaused:
+		switch status {
+		case metav1.ConditionTrue:
+			return MessageReconciliationPaused
+		case metav1.ConditionUnknown:
+		case metav1.ConditionFalse:
+		}
+
+		return MessageReconciliationResumed
+	case ConditionReasonModuleDeprecated:
+		switch status {
+		case metav1.ConditionTrue:
+			return MessageModuleDeprecated
+		case metav1.ConditionUnknown:
+		case metav1.ConditionFalse:
+		}
+
+		return MessageNoModuleDeprecated
+	case ConditionReasonModuleRemoved:
+		return MessageModuleRemoved
+	case ConditionReasonModulesVerified:
+		switch status {
+		case metav1.ConditionTrue:
+			return MessageModulesVerified
+		case metav1.ConditionUnknown:
+		case metav1.ConditionFalse:
+		}
+
+		return MessageModuleUnverified
 	}
 
 	return "no detailed message available as reason is unknown to API"
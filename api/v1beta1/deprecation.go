@@ -0,0 +1,52 @@
+package v1beta1
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Deprecation marks the Module version of a ModuleTemplate in its channel as deprecated. Kymas resolving
+// the ModuleTemplate are warned about the deprecation until the removal date, after which they fail.
+type Deprecation struct {
+	// Message explains the deprecation to the users of the Module, e.g. how to migrate away from it.
+	// +kubebuilder:validation:MinLength:=1
+	Message string `json:"message"`
+
+	// Replacement is the name of the Module that replaces the deprecated Module, if there is one.
+	// +optional
+	Replacement string `json:"replacement,omitempty"`
+
+	// RemovalDate is the time after which the Module version is no longer available in the channel.
+	// If it is not set, the Module version stays available while it is deprecated.
+	// +optional
+	RemovalDate *metav1.Time `json:"removalDate,omitempty"`
+}
+
+// IsRemoved determines if the removal date of the deprecation has passed at the given time.
+func (d *Deprecation) IsRemoved(now time.Time) bool {
+	return d != nil && d.RemovalDate != nil && !now.Before(d.RemovalDate.Time)
+}
+
+// Notice describes the deprecation of the Module version in a channel to the users of the Module.
+func (d *Deprecation) Notice(module, version, channel string) string {
+	notice := fmt.Sprintf("module %s in version %s of channel %s is deprecated", module, version, channel)
+	if d.RemovalDate != nil {
+		notice += " and will be removed on " + d.RemovalDate.UTC().Format(time.RFC3339)
+	}
+	return notice + ": " + d.action()
+}
+
+// RemovalNotice describes what the users of a Module have to do once the Module version was removed.
+func (d *Deprecation) RemovalNotice(module, version, channel string) string {
+	return fmt.Sprintf("module %s in version %s of channel %s was removed on %s: %s",
+		module, version, channel, d.RemovalDate.UTC().Format(time.RFC3339), d.action())
+}
+
+func (d *Deprecation) action() string {
+	if d.Replacement != "" {
+		return fmt.Sprintf("%s (replace the module with %s)", d.Message, d.Replacement)
+	}
+	return fmt.Sprintf("%s (remove the module or switch to another channel)", d.Message)
+}
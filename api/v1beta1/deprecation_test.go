@@ -0,0 +1,82 @@
+package v1beta1_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+)
+
+//nolint:funlen
+func TestDeprecation_Notice(t *testing.T) {
+	t.Parallel()
+	removalDate := metav1.NewTime(time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC))
+	tests := []struct {
+		name           string
+		deprecation    *v1beta1.Deprecation
+		now            time.Time
+		expectedNotice string
+		removed        bool
+	}{
+		{
+			"deprecated without removal date",
+			&v1beta1.Deprecation{Message: "use the fast channel"},
+			removalDate.Add(time.Hour),
+			"module sample in version 1.0.0 of channel regular is deprecated: " +
+				"use the fast channel (remove the module or switch to another channel)",
+			false,
+		},
+		{
+			"deprecated with replacement before removal date",
+			&v1beta1.Deprecation{Message: "superseded", Replacement: "sample-v2", RemovalDate: &removalDate},
+			removalDate.Add(-time.Hour),
+			"module sample in version 1.0.0 of channel regular is deprecated and will be removed on " +
+				"2023-06-01T00:00:00Z: superseded (replace the module with sample-v2)",
+			false,
+		},
+		{
+			"removed at removal date",
+			&v1beta1.Deprecation{Message: "superseded", RemovalDate: &removalDate},
+			removalDate.Time,
+			"module sample in version 1.0.0 of channel regular is deprecated and will be removed on " +
+				"2023-06-01T00:00:00Z: superseded (remove the module or switch to another channel)",
+			true,
+		},
+	}
+	for _, testCase := range tests {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, testCase.expectedNotice, testCase.deprecation.Notice("sample", "1.0.0", "regular"))
+			assert.Equal(t, testCase.removed, testCase.deprecation.IsRemoved(testCase.now))
+		})
+	}
+}
+
+func TestDeprecation_IsRemovedWithoutDeprecation(t *testing.T) {
+	t.Parallel()
+	var deprecation *v1beta1.Deprecation
+	assert.False(t, deprecation.IsRemoved(time.Now()))
+}
+
+func TestKyma_UpdateDeprecationCondition(t *testing.T) {
+	t.Parallel()
+	kyma := &v1beta1.Kyma{}
+
+	kyma.UpdateDeprecationCondition(nil)
+	assert.Nil(t, meta.FindStatusCondition(kyma.Status.Conditions, string(v1beta1.ConditionTypeModuleDeprecation)))
+
+	kyma.UpdateDeprecationCondition([]string{"first notice", "second notice"})
+	condition := meta.FindStatusCondition(kyma.Status.Conditions, string(v1beta1.ConditionTypeModuleDeprecation))
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Equal(t, "first notice; second notice", condition.Message)
+
+	kyma.UpdateDeprecationCondition(nil)
+	condition = meta.FindStatusCondition(kyma.Status.Conditions, string(v1beta1.ConditionTypeModuleDeprecation))
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Equal(t, v1beta1.MessageNoModuleDeprecated, condition.Message)
+}
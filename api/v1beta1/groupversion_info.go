@@ -29,6 +29,7 @@ import (
 const (
 	KymaKind           Kind = "Kyma"
 	ModuleTemplateKind Kind = "ModuleTemplate"
+	ModuleRolloutKind  Kind = "ModuleRollout"
 	WatcherKind        Kind = "Watcher"
 )
 
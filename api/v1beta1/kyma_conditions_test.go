@@ -0,0 +1,179 @@
+package v1beta1_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type subsystemCondition struct {
+	conditionType v1beta1.KymaConditionType
+	reason        v1beta1.KymaConditionReason
+	status        metav1.ConditionStatus
+}
+
+//nolint:funlen
+func TestKyma_UpdateReadyCondition(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name            string
+		conditions      []subsystemCondition
+		expectedStatus  metav1.ConditionStatus
+		expectedMessage string
+		expectedState   v1beta1.State
+	}{
+		{
+			"all subsystems ready",
+			[]subsystemCondition{
+				{v1beta1.ConditionTypeModules, v1beta1.ConditionReasonModulesAreReady, metav1.ConditionTrue},
+				{v1beta1.ConditionTypeModuleCatalog, v1beta1.ConditionReasonModuleCatalogIsReady, metav1.ConditionTrue},
+			},
+			metav1.ConditionTrue,
+			v1beta1.MessageKymaIsReady,
+			v1beta1.StateReady,
+		},
+		{
+			"single subsystem not ready",
+			[]subsystemCondition{
+				{v1beta1.ConditionTypeModules, v1beta1.ConditionReasonModulesAreReady, metav1.ConditionTrue},
+				{v1beta1.ConditionTypeSKRWebhook, v1beta1.ConditionReasonSKRWebhookIsReady, metav1.ConditionFalse},
+			},
+			metav1.ConditionFalse,
+			v1beta1.MessageKymaIsNotReady + ": SKRWebhook",
+			v1beta1.StateProcessing,
+		},
+		{
+			"required subsystems not reported yet",
+			[]subsystemCondition{
+				{v1beta1.ConditionTypeSKRWebhook, v1beta1.ConditionReasonSKRWebhookIsReady, metav1.ConditionTrue},
+			},
+			metav1.ConditionUnknown,
+			v1beta1.MessageKymaIsNotReady + ": waiting for Modules",
+			v1beta1.StateProcessing,
+		},
+		{
+			"subsystems not ready and not reported yet",
+			[]subsystemCondition{
+				{v1beta1.ConditionTypeSKRWebhook, v1beta1.ConditionReasonSKRWebhookIsReady, metav1.ConditionFalse},
+			},
+			metav1.ConditionFalse,
+			v1beta1.MessageKymaIsNotReady + ": SKRWebhook, waiting for Modules",
+			v1beta1.StateProcessing,
+		},
+		{
+			"multiple subsystems not ready",
+			[]subsystemCondition{
+				{v1beta1.ConditionTypeModules, v1beta1.ConditionReasonModulesAreReady, metav1.ConditionFalse},
+				{v1beta1.ConditionTypeRemoteSync, v1beta1.ConditionReasonRemoteKymaIsSynced, metav1.ConditionFalse},
+			},
+			metav1.ConditionFalse,
+			v1beta1.MessageKymaIsNotReady + ": Modules, RemoteSync",
+			v1beta1.StateProcessing,
+		},
+	}
+	for _, testCase := range tests {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			kyma := &v1beta1.Kyma{}
+			kyma.SetGeneration(2)
+			for _, condition := range testCase.conditions {
+				kyma.UpdateCondition(condition.conditionType, condition.reason, condition.status)
+			}
+			kyma.UpdateReadyCondition()
+
+			ready := meta.FindStatusCondition(kyma.Status.Conditions, string(v1beta1.ConditionTypeReady))
+			assert.NotNil(t, ready)
+			assert.Equal(t, testCase.expectedStatus, ready.Status)
+			assert.Equal(t, testCase.expectedMessage, ready.Message)
+			assert.Equal(t, int64(2), ready.ObservedGeneration)
+			assert.Equal(t, testCase.expectedState, kyma.DetermineState())
+		})
+	}
+}
+
+func TestKyma_UpdateReadyCondition_NewKyma(t *testing.T) {
+	t.Parallel()
+	kyma := &v1beta1.Kyma{}
+	kyma.Spec.Sync = v1beta1.Sync{Enabled: true, ModuleCatalog: true}
+	kyma.UpdateReadyCondition()
+
+	ready := meta.FindStatusCondition(kyma.Status.Conditions, string(v1beta1.ConditionTypeReady))
+	assert.NotNil(t, ready)
+	assert.Equal(t, metav1.ConditionUnknown, ready.Status)
+	assert.Equal(t, v1beta1.MessageKymaIsNotReady+": waiting for Modules, ModuleCatalog, RemoteSync", ready.Message)
+	assert.Equal(t, v1beta1.StateProcessing, kyma.DetermineState())
+
+	kyma.UpdateCondition(v1beta1.ConditionTypeModules, v1beta1.ConditionReasonModulesAreReady, metav1.ConditionTrue)
+	kyma.UpdateCondition(v1beta1.ConditionTypeModuleCatalog,
+		v1beta1.ConditionReasonModuleCatalogIsReady, metav1.ConditionTrue)
+	kyma.UpdateCondition(v1beta1.ConditionTypeRemoteSync,
+		v1beta1.ConditionReasonRemoteKymaIsSynced, metav1.ConditionTrue)
+	kyma.UpdateReadyCondition()
+	ready = meta.FindStatusCondition(kyma.Status.Conditions, string(v1beta1.ConditionTypeReady))
+	assert.Equal(t, metav1.ConditionTrue, ready.Status)
+	assert.Equal(t, v1beta1.StateReady, kyma.DetermineState())
+}
+
+func TestKyma_RemoveCondition(t *testing.T) {
+	t.Parallel()
+	kyma := &v1beta1.Kyma{}
+	kyma.UpdateCondition(v1beta1.ConditionTypeModules, v1beta1.ConditionReasonModulesAreReady, metav1.ConditionTrue)
+	kyma.UpdateCondition(v1beta1.ConditionTypeSKRWebhook,
+		v1beta1.ConditionReasonSKRWebhookIsReady, metav1.ConditionFalse)
+	kyma.RemoveCondition(v1beta1.ConditionTypeSKRWebhook)
+	kyma.UpdateReadyCondition()
+
+	ready := meta.FindStatusCondition(kyma.Status.Conditions, string(v1beta1.ConditionTypeReady))
+	assert.NotNil(t, ready)
+	assert.Equal(t, metav1.ConditionTrue, ready.Status)
+}
+
+func TestKyma_UpdatePausedCondition(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	kyma := &v1beta1.Kyma{}
+
+	assert.False(t, kyma.UpdatePausedCondition(now))
+	assert.Nil(t, meta.FindStatusCondition(kyma.Status.Conditions, string(v1beta1.ConditionTypePaused)))
+
+	until := metav1.NewTime(now.Add(time.Hour))
+	kyma.Spec.Pause = &v1beta1.Pause{Reason: "incident 42", PausedBy: "sre", Until: &until}
+	assert.True(t, kyma.UpdatePausedCondition(now))
+	paused := meta.FindStatusCondition(kyma.Status.Conditions, string(v1beta1.ConditionTypePaused))
+	assert.Equal(t, metav1.ConditionTrue, paused.Status)
+	assert.Contains(t, paused.Message, "by sre")
+	assert.Contains(t, paused.Message, "incident 42")
+
+	assert.False(t, kyma.UpdatePausedCondition(now.Add(2*time.Hour)))
+	paused = meta.FindStatusCondition(kyma.Status.Conditions, string(v1beta1.ConditionTypePaused))
+	assert.Equal(t, metav1.ConditionFalse, paused.Status)
+	assert.Equal(t, v1beta1.MessageReconciliationResumed, paused.Message)
+
+	kyma.UpdateCondition(v1beta1.ConditionTypeModules, v1beta1.ConditionReasonModulesAreReady, metav1.ConditionTrue)
+	kyma.UpdateReadyCondition()
+	ready := meta.FindStatusCondition(kyma.Status.Conditions, string(v1beta1.ConditionTypeReady))
+	assert.Equal(t, metav1.ConditionTrue, ready.Status)
+}
+
+func TestKyma_UpdateVerificationCondition(t *testing.T) {
+	t.Parallel()
+	kyma := &v1beta1.Kyma{}
+	kyma.UpdateVerificationCondition(nil)
+	assert.Nil(t, meta.FindStatusCondition(kyma.Status.Conditions, string(v1beta1.ConditionTypeModuleVerification)))
+
+	kyma.UpdateVerificationCondition([]string{"module sample is not verified", "module other is not verified"})
+	verification := meta.FindStatusCondition(kyma.Status.Conditions, string(v1beta1.ConditionTypeModuleVerification))
+	assert.Equal(t, metav1.ConditionFalse, verification.Status)
+	assert.Equal(t, string(v1beta1.ConditionReasonModulesVerified), verification.Reason)
+	assert.Equal(t, "module sample is not verified; module other is not verified", verification.Message)
+
+	kyma.UpdateVerificationCondition(nil)
+	verification = meta.FindStatusCondition(kyma.Status.Conditions, string(v1beta1.ConditionTypeModuleVerification))
+	assert.Equal(t, metav1.ConditionTrue, verification.Status)
+	assert.Equal(t, v1beta1.MessageModulesVerified, verification.Message)
+}
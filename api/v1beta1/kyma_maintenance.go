@@ -0,0 +1,91 @@
+package v1beta1
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrInvalidMaintenanceWindow = errors.New("invalid maintenance window")
+
+const (
+	maintenanceTimeLayout = "15:04"
+	minutesPerHour        = 60
+)
+
+// IsInMaintenanceWindow determines whether version changes of installed modules may be applied at the given time.
+// A Kyma without Maintenance is always considered to be within its maintenance window.
+func (kyma *Kyma) IsInMaintenanceWindow(now time.Time) (bool, error) {
+	maintenance := kyma.Spec.Maintenance
+	if maintenance == nil || len(maintenance.Windows) == 0 {
+		return true, nil
+	}
+
+	location := time.UTC
+	if maintenance.TimeZone != "" {
+		var err error
+		if location, err = time.LoadLocation(maintenance.TimeZone); err != nil {
+			return false, fmt.Errorf("%w: unknown time zone %s: %s",
+				ErrInvalidMaintenanceWindow, maintenance.TimeZone, err.Error())
+		}
+	}
+	now = now.In(location)
+
+	for _, window := range maintenance.Windows {
+		active, err := window.IsActive(now)
+		if err != nil {
+			return false, err
+		}
+		if active {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// IsActive determines whether the window is open at the given time, evaluated in the location of the time.
+func (w MaintenanceWindow) IsActive(now time.Time) (bool, error) {
+	start, err := minuteOfDay(w.Start)
+	if err != nil {
+		return false, err
+	}
+	end, err := minuteOfDay(w.End)
+	if err != nil {
+		return false, err
+	}
+	minute := now.Hour()*minutesPerHour + now.Minute()
+
+	switch {
+	case start == end:
+		return w.opensOn(now.Weekday()), nil
+	case start < end:
+		return w.opensOn(now.Weekday()) && minute >= start && minute < end, nil
+	case minute >= start:
+		return w.opensOn(now.Weekday()), nil
+	case minute < end:
+		// the window spans midnight, so the time after midnight belongs to the window opened the day before
+		return w.opensOn(now.AddDate(0, 0, -1).Weekday()), nil
+	default:
+		return false, nil
+	}
+}
+
+func (w MaintenanceWindow) opensOn(day time.Weekday) bool {
+	if len(w.Days) == 0 {
+		return true
+	}
+	for _, windowDay := range w.Days {
+		if string(windowDay) == day.String() {
+			return true
+		}
+	}
+	return false
+}
+
+func minuteOfDay(clock string) (int, error) {
+	parsed, err := time.Parse(maintenanceTimeLayout, clock)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s is not in format HH:MM: %s", ErrInvalidMaintenanceWindow, clock, err.Error())
+	}
+	return parsed.Hour()*minutesPerHour + parsed.Minute(), nil
+}
@@ -0,0 +1,115 @@
+package v1beta1_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+)
+
+//nolint:funlen
+func TestKyma_IsInMaintenanceWindow(t *testing.T) {
+	t.Parallel()
+	// 2023-02-15 is a Wednesday
+	wednesdayNoon := time.Date(2023, time.February, 15, 12, 0, 0, 0, time.UTC)
+	thursdayEarly := time.Date(2023, time.February, 16, 1, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		maintenance *v1beta1.Maintenance
+		now         time.Time
+		expected    bool
+		expectErr   bool
+	}{
+		{
+			"no maintenance is always active",
+			nil,
+			wednesdayNoon,
+			true,
+			false,
+		},
+		{
+			"daily window containing the time",
+			&v1beta1.Maintenance{Windows: []v1beta1.MaintenanceWindow{{Start: "11:00", End: "13:00"}}},
+			wednesdayNoon,
+			true,
+			false,
+		},
+		{
+			"daily window ending at the time",
+			&v1beta1.Maintenance{Windows: []v1beta1.MaintenanceWindow{{Start: "10:00", End: "12:00"}}},
+			wednesdayNoon,
+			false,
+			false,
+		},
+		{
+			"window on a different weekday",
+			&v1beta1.Maintenance{Windows: []v1beta1.MaintenanceWindow{
+				{Days: []v1beta1.Weekday{"Monday", "Tuesday"}, Start: "11:00", End: "13:00"},
+			}},
+			wednesdayNoon,
+			false,
+			false,
+		},
+		{
+			"window spanning midnight opened the day before",
+			&v1beta1.Maintenance{Windows: []v1beta1.MaintenanceWindow{
+				{Days: []v1beta1.Weekday{"Wednesday"}, Start: "22:00", End: "02:00"},
+			}},
+			thursdayEarly,
+			true,
+			false,
+		},
+		{
+			"window spanning midnight not opened the day before",
+			&v1beta1.Maintenance{Windows: []v1beta1.MaintenanceWindow{
+				{Days: []v1beta1.Weekday{"Thursday"}, Start: "22:00", End: "02:00"},
+			}},
+			thursdayEarly,
+			false,
+			false,
+		},
+		{
+			"window evaluated in a different time zone",
+			&v1beta1.Maintenance{
+				TimeZone: "Asia/Tokyo",
+				Windows:  []v1beta1.MaintenanceWindow{{Start: "20:00", End: "22:00"}},
+			},
+			wednesdayNoon,
+			true,
+			false,
+		},
+		{
+			"unknown time zone",
+			&v1beta1.Maintenance{
+				TimeZone: "Nowhere/Unknown",
+				Windows:  []v1beta1.MaintenanceWindow{{Start: "11:00", End: "13:00"}},
+			},
+			wednesdayNoon,
+			false,
+			true,
+		},
+		{
+			"malformed window",
+			&v1beta1.Maintenance{Windows: []v1beta1.MaintenanceWindow{{Start: "11", End: "13:00"}}},
+			wednesdayNoon,
+			false,
+			true,
+		},
+	}
+	for _, testCase := range tests {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			kyma := &v1beta1.Kyma{Spec: v1beta1.KymaSpec{Maintenance: testCase.maintenance}}
+			active, err := kyma.IsInMaintenanceWindow(testCase.now)
+			if testCase.expectErr {
+				assert.ErrorIs(t, err, v1beta1.ErrInvalidMaintenanceWindow)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expected, active)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package v1beta1_test
+
+import (
+	"testing"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+)
+
+func kymaWithModules(spec []string, status []string) *v1beta1.Kyma {
+	kyma := &v1beta1.Kyma{}
+	for _, name := range spec {
+		kyma.Spec.Modules = append(kyma.Spec.Modules, v1beta1.Module{Name: name})
+	}
+	for _, name := range status {
+		kyma.Status.Modules = append(kyma.Status.Modules, v1beta1.ModuleStatus{Name: name})
+	}
+	return kyma
+}
+
+func moduleStatusNames(moduleStatus []v1beta1.ModuleStatus) []string {
+	var names []string
+	for _, status := range moduleStatus {
+		names = append(names, status.Name)
+	}
+	return names
+}
+
+func TestKyma_GetNoLongerExistingModuleStatus(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		spec     []string
+		status   []string
+		expected []string
+	}{
+		{"no module removed", []string{"a", "b"}, []string{"a", "b"}, nil},
+		{"module removed from the tail", []string{"a", "b"}, []string{"a", "b", "c"}, []string{"c"}},
+		{"module removed from the middle", []string{"a", "c"}, []string{"a", "b", "c"}, []string{"b"}},
+		{"module removed and added", []string{"a", "d"}, []string{"a", "b"}, []string{"b"}},
+	}
+	for _, testCase := range tests {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			kyma := kymaWithModules(testCase.spec, testCase.status)
+			assert.Equal(t, testCase.expected, moduleStatusNames(kyma.GetNoLongerExistingModuleStatus()))
+		})
+	}
+}
+
+func TestKyma_SetAndRemoveModuleStatus(t *testing.T) {
+	t.Parallel()
+	kyma := kymaWithModules([]string{"a", "c"}, []string{"a", "b", "c"})
+
+	kyma.SetModuleStatus(v1beta1.ModuleStatus{Name: "c", State: v1beta1.StateReady})
+	kyma.SetModuleStatus(v1beta1.ModuleStatus{Name: "d", State: v1beta1.StateProcessing})
+	assert.Equal(t, []string{"a", "b", "c", "d"}, moduleStatusNames(kyma.Status.Modules))
+	assert.Equal(t, v1beta1.StateReady, kyma.GetModuleStatus("c").State)
+
+	kyma.RemoveModuleStatus("b")
+	assert.Equal(t, []string{"a", "c", "d"}, moduleStatusNames(kyma.Status.Modules))
+	assert.Nil(t, kyma.GetModuleStatus("b"))
+}
@@ -17,10 +17,13 @@ limitations under the License.
 package v1beta1
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 //+genclient
@@ -62,8 +65,28 @@ type Module struct {
 	// +kubebuilder:validation:MinLength:=3
 	Channel string `json:"channel,omitempty"`
 
+	// Version is an optional exact version or semantic version range (e.g. 1.4.2 or ~1.4) of the Module.
+	// If it is set, the ModuleTemplate with the highest version matching it is resolved across all channels
+	// instead of using the desired Channel, which allows freezing a Module while its channel moves on.
+	// +kubebuilder:validation:MaxLength:=64
+	Version string `json:"version,omitempty"`
+
+	// ResolutionStrategy determines how the ModuleTemplate is resolved if several ModuleTemplates of the Module
+	// are assigned to the desired Channel. If it is not set, the strategy configured for the operator is used.
+	// +optional
+	ResolutionStrategy TemplateResolutionStrategy `json:"resolutionStrategy,omitempty"`
+
 	// +kubebuilder:default:=CreateAndDelete
 	CustomResourcePolicy `json:"customResourcePolicy,omitempty"`
+
+	// Config is merged into the default custom resource of the ModuleTemplate as a JSON Merge Patch (RFC 7386),
+	// e.g. {"spec":{"replicas":3}}, so that the Module can be customised without editing the resource in the
+	// runtime. The merged resource is validated against the schema of the Module CRD if it is already installed.
+	// Like the rest of the default custom resource, it is only applied when the resource is created.
+	// It has no effect if the CustomResourcePolicy is Ignore.
+	//+kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Config *runtime.RawExtension `json:"config,omitempty"`
 }
 
 // CustomResourcePolicy determines how a ModuleTemplate should be parsed. When CustomResourcePolicy is set to
@@ -83,11 +106,27 @@ const (
 	CustomResourcePolicyIgnore = "Ignore"
 )
 
+// TemplateResolutionStrategy determines how a ModuleTemplate is resolved if several ModuleTemplates of a Module
+// are assigned to the same channel and no ModuleRollout stages one of them.
+// +kubebuilder:validation:Enum=Fail;HighestVersion;Newest
+type TemplateResolutionStrategy string
+
+const (
+	// TemplateResolutionFail fails the resolution of the Module until only one ModuleTemplate is left.
+	TemplateResolutionFail TemplateResolutionStrategy = "Fail"
+	// TemplateResolutionHighestVersion resolves the ModuleTemplate with the highest semantic version.
+	TemplateResolutionHighestVersion TemplateResolutionStrategy = "HighestVersion"
+	// TemplateResolutionNewest resolves the ModuleTemplate that was created last.
+	TemplateResolutionNewest TemplateResolutionStrategy = "Newest"
+)
+
 // SyncStrategy determines how the Remote Cluster is synchronized with the Control Plane. This can influence secret
 // lookup, or other behavioral patterns when interacting with the remote cluster.
 type SyncStrategy string
 
 const (
+	// SyncStrategySecret is the default strategy and behaves like SyncStrategyLocalSecret.
+	SyncStrategySecret      = "secret"
 	SyncStrategyLocalSecret = "local-secret"
 	SyncStrategyLocalClient = "local-client"
 )
@@ -134,22 +173,90 @@ type KymaSpec struct {
 	// Active Synchronization Settings
 	// +optional
 	Sync Sync `json:"sync,omitempty"`
+
+	// Maintenance restricts the time at which version changes of already installed modules are applied.
+	// If it is not set, version changes are applied as soon as they are detected.
+	// +optional
+	Maintenance *Maintenance `json:"maintenance,omitempty"`
+
+	// DeletionPolicy determines what happens to the installed Modules when the Kyma is deleted.
+	// Cascade removes the Modules, the synchronized Kyma and the Module Catalog from the remote cluster.
+	// Orphan keeps all of them in the remote cluster and only removes the bookkeeping in the Control Plane,
+	// e.g. to migrate the cluster to another Control Plane without downtime.
+	// +kubebuilder:default:=Cascade
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// Pause suspends the reconciliation of the Kyma and all of its Manifests until it is removed or expires.
+	// +optional
+	Pause *Pause `json:"pause,omitempty"`
+
+	// VerificationPolicy determines how the signatures of the Modules are verified. If it is not set,
+	// the policy is read from the VerificationPolicyAnnotation of the namespace of the Kyma and defaults to
+	// Enforce if module verification is enabled for the operator and to Off otherwise.
+	// +optional
+	VerificationPolicy VerificationPolicy `json:"verificationPolicy,omitempty"`
 }
 
-func (kyma *Kyma) AllReadyConditionsTrue() bool {
-	status := &kyma.Status
-	if len(status.Conditions) < 1 {
-		return false
-	}
+// VerificationPolicy determines how the signatures of the ModuleTemplates resolved by a Kyma are verified.
+// +kubebuilder:validation:Enum=Enforce;Warn;Off
+type VerificationPolicy string
 
-	for _, existingCondition := range status.Conditions {
-		if existingCondition.Type == string(ConditionTypeReady) &&
-			existingCondition.Status != metav1.ConditionTrue {
-			return false
-		}
-	}
+const (
+	// VerificationPolicyEnforce fails a Module whose signatures cannot be verified.
+	VerificationPolicyEnforce VerificationPolicy = "Enforce"
+	// VerificationPolicyWarn installs a Module whose signatures cannot be verified,
+	// but reports it in the ModuleVerification condition and an event.
+	VerificationPolicyWarn VerificationPolicy = "Warn"
+	// VerificationPolicyOff skips the verification of signatures, e.g. for unsigned Modules in development.
+	VerificationPolicyOff VerificationPolicy = "Off"
+)
+
+// DeletionPolicy determines if the installed Modules are removed together with the Kyma.
+// +kubebuilder:validation:Enum=Cascade;Orphan
+type DeletionPolicy string
+
+const (
+	DeletionPolicyCascade DeletionPolicy = "Cascade"
+	DeletionPolicyOrphan  DeletionPolicy = "Orphan"
+)
 
-	return true
+// Maintenance defines recurring time ranges in which version changes of already installed modules are applied.
+// Outside of these windows, a version change stays pending and is tracked in the ModuleStatus.
+// New modules are always installed immediately.
+type Maintenance struct {
+	// TimeZone is the IANA Time Zone name the Windows are evaluated in, e.g. Europe/Berlin.
+	// +kubebuilder:default:=UTC
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// Windows is the list of recurring time ranges during which version changes are applied.
+	// +kubebuilder:validation:MinItems:=1
+	Windows []MaintenanceWindow `json:"windows"`
+}
+
+// MaintenanceWindow is a recurring time range that opens on a set of weekdays.
+type MaintenanceWindow struct {
+	// Days on which the window opens. If empty, the window opens every day.
+	// +optional
+	Days []Weekday `json:"days,omitempty"`
+
+	// Start of the window in 24h format (HH:MM).
+	// +kubebuilder:validation:Pattern:="^([01][0-9]|2[0-3]):[0-5][0-9]$"
+	Start string `json:"start"`
+
+	// End of the window in 24h format (HH:MM). If End is before Start, the window spans midnight
+	// and closes on the following day. If End equals Start, the window lasts the whole day.
+	// +kubebuilder:validation:Pattern:="^([01][0-9]|2[0-3]):[0-5][0-9]$"
+	End string `json:"end"`
+}
+
+// Weekday is the english name of a day of the week as used by time.Weekday.
+// +kubebuilder:validation:Enum=Monday;Tuesday;Wednesday;Thursday;Friday;Saturday;Sunday
+type Weekday string
+
+func (kyma *Kyma) AllReadyConditionsTrue() bool {
+	notReady, waiting := kyma.notReadySubsystems()
+	return len(notReady) == 0 && len(waiting) == 0
 }
 
 // KymaStatus defines the observed state of Kyma
@@ -163,16 +270,65 @@ type KymaStatus struct {
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 
-	// Contains essential information about the current deployed module
+	// Contains essential information about the current deployed module, keyed by the name of the module.
+	// +listType=map
+	// +listMapKey=name
 	Modules []ModuleStatus `json:"modules,omitempty"`
 
 	// Active Channel
 	// +optional
 	ActiveChannel string `json:"activeChannel,omitempty"`
 
+	// Plan contains the changes to Modules a reconciliation would apply.
+	// It is only generated while the Kyma is annotated for a dry-run, see DryRunAnnotation.
+	// +optional
+	Plan *KymaPlan `json:"plan,omitempty"`
+
+	// ModuleHistory records the installations, version changes, channel switches and removals of Modules,
+	// ordered from oldest to newest. Only the latest ModuleHistoryLimit entries are kept per Module.
+	// +optional
+	ModuleHistory []ModuleHistoryEntry `json:"moduleHistory,omitempty"`
+
 	LastOperation `json:"lastOperation,omitempty"`
 }
 
+// KymaPlan describes the changes to Modules a reconciliation of the Kyma would apply.
+type KymaPlan struct {
+	// Create contains all Modules that would be installed.
+	// +optional
+	Create []PlannedModule `json:"create,omitempty"`
+
+	// Update contains all installed Modules that would change their version or channel.
+	// +optional
+	Update []PlannedModule `json:"update,omitempty"`
+
+	// Prune contains all installed Modules that would be deleted as they are no longer part of the spec.
+	// +optional
+	Prune []PlannedModule `json:"prune,omitempty"`
+}
+
+// PlannedModule describes the change of a single Module in a KymaPlan.
+type PlannedModule struct {
+	// Name is the name of the Module in the spec.
+	Name string `json:"name"`
+
+	// Channel is the channel the Module would be installed from.
+	// +optional
+	Channel string `json:"channel,omitempty"`
+
+	// Version is the version the Module would be installed with.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// PreviousChannel is the channel the Module is currently installed from.
+	// +optional
+	PreviousChannel string `json:"previousChannel,omitempty"`
+
+	// PreviousVersion is the version the Module is currently installed with.
+	// +optional
+	PreviousVersion string `json:"previousVersion,omitempty"`
+}
+
 type LastOperation struct {
 	Operation      string      `json:"operation"`
 	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitempty"`
@@ -227,8 +383,29 @@ type ModuleStatus struct {
 	// Channel tracks the active Version of the Module.
 	Version string `json:"version"`
 
-	// State of the Module in the currently tracked Generation
+	// Resolution describes how the ModuleTemplate was chosen if several ModuleTemplates of the Module
+	// were assigned to its Channel.
+	// +optional
+	Resolution string `json:"resolution,omitempty"`
+
+	// PendingVersion is set if an upgrade to a new Version of the Module is pending, because it was
+	// detected outside the maintenance window of the Kyma. It is applied once the next window opens.
+	// +optional
+	PendingVersion string `json:"pendingVersion,omitempty"`
+
+	// BlockedDowngradeVersion is set if the Module would be downgraded to this lower Version while downgrades
+	// are blocked. The installed Version is kept until the Kyma allows the downgrade through an annotation.
+	// +optional
+	BlockedDowngradeVersion string `json:"blockedDowngradeVersion,omitempty"`
+
+	// State of the Module in the currently tracked Generation.
+	// It is Deleting once the Module was removed from the Spec until its Manifest is gone.
 	State State `json:"state"`
+
+	// DeletionTriggeredAt records when the Module was first found removed from the Spec,
+	// which triggers the deletion of its Manifest.
+	// +optional
+	DeletionTriggeredAt *metav1.Time `json:"deletionTriggeredAt,omitempty"`
 }
 
 // TrackingObject contains metav1.TypeMeta and PartialMeta to allow a generation based object tracking.
@@ -290,10 +467,50 @@ type KymaConditionType string
 
 const (
 	// ConditionTypeReady represents KymaConditionType Ready, meaning as soon as its true we will reconcile Kyma
-	// into KymaStateReady.
+	// into KymaStateReady. It is derived from the conditions of all subsystems of the Kyma.
 	ConditionTypeReady KymaConditionType = "Ready"
+
+	// ConditionTypeModules represents the readiness of all Modules of the Kyma.
+	ConditionTypeModules KymaConditionType = "Modules"
+
+	// ConditionTypeModuleCatalog represents the synchronization of the ModuleTemplates into the remote cluster.
+	ConditionTypeModuleCatalog KymaConditionType = "ModuleCatalog"
+
+	// ConditionTypeSKRWebhook represents the installation of the watcher webhook into the remote cluster.
+	ConditionTypeSKRWebhook KymaConditionType = "SKRWebhook"
+
+	// ConditionTypeRemoteSync represents the synchronization of the Kyma with the remote cluster.
+	ConditionTypeRemoteSync KymaConditionType = "RemoteSync"
+
+	// ConditionTypeDowngradeProtection represents the protection of installed Modules against downgrades.
+	// It is false as long as the downgrade of a Module is blocked. As the installed Modules stay untouched,
+	// it does not influence the Ready condition.
+	ConditionTypeDowngradeProtection KymaConditionType = "DowngradeProtection"
+
+	// ConditionTypePaused represents a Pause of the reconciliation of the Kyma.
+	// It is only present once the Kyma was paused and is not considered for the Ready condition.
+	ConditionTypePaused KymaConditionType = "Paused"
+
+	// ConditionTypeModuleDeprecation represents the deprecation of Modules resolved by the Kyma.
+	// It is true as long as a resolved ModuleTemplate is deprecated and is not considered for the Ready condition.
+	ConditionTypeModuleDeprecation KymaConditionType = "ModuleDeprecation"
+
+	// ConditionTypeModuleVerification represents the signature verification of Modules under the Warn policy.
+	// It is false as long as a Module is installed although its signatures could not be verified,
+	// and is not considered for the Ready condition.
+	ConditionTypeModuleVerification KymaConditionType = "ModuleVerification"
 )
 
+// SubsystemConditionTypes are the condition types of all subsystems the Ready condition is derived from.
+//
+//nolint:gochecknoglobals
+var SubsystemConditionTypes = []KymaConditionType{
+	ConditionTypeModules,
+	ConditionTypeModuleCatalog,
+	ConditionTypeSKRWebhook,
+	ConditionTypeRemoteSync,
+}
+
 // KymaConditionReason is a programmatic identifier indicating the reason for the condition's last transition.
 // By combining of condition status, it explains the current Kyma status for all modules.
 // Name example:
@@ -319,13 +536,52 @@ func (kyma *Kyma) SetLastSync() *Kyma {
 	return kyma
 }
 
+// GetNoLongerExistingModuleStatus returns the status of all Modules that are no longer part of the Spec,
+// independent of their position in the Spec.
 func (kyma *Kyma) GetNoLongerExistingModuleStatus() []ModuleStatus {
-	if len(kyma.Status.Modules) > len(kyma.Spec.Modules) {
-		return kyma.Status.Modules[len(kyma.Spec.Modules):]
+	desired := make(map[string]bool, len(kyma.Spec.Modules))
+	for _, module := range kyma.Spec.Modules {
+		desired[module.Name] = true
+	}
+	var noLongerExisting []ModuleStatus
+	for _, moduleStatus := range kyma.Status.Modules {
+		if !desired[moduleStatus.Name] {
+			noLongerExisting = append(noLongerExisting, moduleStatus)
+		}
+	}
+	return noLongerExisting
+}
+
+// GetModuleStatus returns the status of the Module with the given name or nil if it is not tracked.
+func (kyma *Kyma) GetModuleStatus(moduleName string) *ModuleStatus {
+	for i := range kyma.Status.Modules {
+		if kyma.Status.Modules[i].Name == moduleName {
+			return &kyma.Status.Modules[i]
+		}
 	}
 	return nil
 }
 
+// SetModuleStatus replaces the status of the Module with the same name or adds it if it is not tracked yet.
+func (kyma *Kyma) SetModuleStatus(moduleStatus ModuleStatus) {
+	if existing := kyma.GetModuleStatus(moduleStatus.Name); existing != nil {
+		*existing = moduleStatus
+		return
+	}
+	kyma.Status.Modules = append(kyma.Status.Modules, moduleStatus)
+}
+
+// RemoveModuleStatus stops tracking the status of the Module with the given name.
+func (kyma *Kyma) RemoveModuleStatus(moduleName string) {
+	modules := kyma.Status.Modules[:0]
+	for _, moduleStatus := range kyma.Status.Modules {
+		if moduleStatus.Name != moduleName {
+			modules = append(modules, moduleStatus)
+		}
+	}
+	kyma.Status.Modules = modules
+}
+
 //+kubebuilder:object:root=true
 
 // KymaList contains a list of Kyma.
@@ -335,21 +591,91 @@ type KymaList struct {
 	Items           []Kyma `json:"items"`
 }
 
+// KymaModuleTemplateField is the field path under which Kymas are indexed in the cache of the manager
+// by the ModuleTemplates resolved for their Modules, see pkg/index.
+const KymaModuleTemplateField = "status.modules.template"
+
 //nolint:gochecknoinits
 func init() {
 	SchemeBuilder.Register(&Kyma{}, &KymaList{})
 }
 
-func (kyma *Kyma) UpdateCondition(reason KymaConditionReason, status metav1.ConditionStatus) {
+func (kyma *Kyma) UpdateCondition(
+	conditionType KymaConditionType, reason KymaConditionReason, status metav1.ConditionStatus,
+) {
+	kyma.UpdateConditionWithMessage(conditionType, reason, status, GenerateMessage(reason, status))
+}
+
+// UpdateConditionWithMessage sets the condition like UpdateCondition, but with a message that details
+// the generated message of the reason, e.g. with the affected Modules.
+func (kyma *Kyma) UpdateConditionWithMessage(
+	conditionType KymaConditionType, reason KymaConditionReason, status metav1.ConditionStatus, message string,
+) {
 	meta.SetStatusCondition(&kyma.Status.Conditions, metav1.Condition{
-		Type:               string(ConditionTypeReady),
+		Type:               string(conditionType),
 		Status:             status,
 		Reason:             string(reason),
-		Message:            GenerateMessage(reason, status),
+		Message:            message,
 		ObservedGeneration: kyma.GetGeneration(),
 	})
 }
 
+// RemoveCondition removes the condition of a subsystem that is no longer in use.
+func (kyma *Kyma) RemoveCondition(conditionType KymaConditionType) {
+	meta.RemoveStatusCondition(&kyma.Status.Conditions, string(conditionType))
+}
+
+// UpdateReadyCondition derives the Ready condition from the conditions of all subsystems.
+// It is only true if all required subsystem conditions exist and all existing subsystem conditions are true.
+// It is unknown as long as a required subsystem has not reported its condition yet, e.g. for a new Kyma.
+func (kyma *Kyma) UpdateReadyCondition() {
+	status := metav1.ConditionTrue
+	message := MessageKymaIsReady
+	notReady, waiting := kyma.notReadySubsystems()
+	reasons := notReady
+	if len(waiting) > 0 {
+		status = metav1.ConditionUnknown
+		reasons = append(reasons, "waiting for "+strings.Join(waiting, ", "))
+	}
+	if len(notReady) > 0 {
+		status = metav1.ConditionFalse
+	}
+	if len(reasons) > 0 {
+		message = fmt.Sprintf("%s: %s", MessageKymaIsNotReady, strings.Join(reasons, ", "))
+	}
+	meta.SetStatusCondition(&kyma.Status.Conditions, metav1.Condition{
+		Type:               string(ConditionTypeReady),
+		Status:             status,
+		Reason:             string(ConditionReasonKymaIsReady),
+		Message:            message,
+		ObservedGeneration: kyma.GetGeneration(),
+	})
+}
+
+// notReadySubsystems returns the subsystems whose conditions are not true and the required subsystems
+// that have no condition yet.
+func (kyma *Kyma) notReadySubsystems() ([]string, []string) {
+	var notReady, waiting []string
+	for _, conditionType := range SubsystemConditionTypes {
+		condition := meta.FindStatusCondition(kyma.Status.Conditions, string(conditionType))
+		switch {
+		case condition == nil && kyma.requiresSubsystem(conditionType):
+			waiting = append(waiting, string(conditionType))
+		case condition != nil && condition.Status != metav1.ConditionTrue:
+			notReady = append(notReady, condition.Type)
+		}
+	}
+	return notReady, waiting
+}
+
+// requiresSubsystem determines if the subsystem has to report its condition before the Kyma can be ready.
+// The SKRWebhook is only installed if the operator is configured for it, so it is not required.
+func (kyma *Kyma) requiresSubsystem(conditionType KymaConditionType) bool {
+	return conditionType == ConditionTypeModules ||
+		(conditionType == ConditionTypeRemoteSync && kyma.Spec.Sync.Enabled) ||
+		(conditionType == ConditionTypeModuleCatalog && kyma.Spec.Sync.Enabled && kyma.Spec.Sync.ModuleCatalog)
+}
+
 func (kyma *Kyma) ContainsCondition(conditionType KymaConditionType,
 	reason KymaConditionReason, conditionStatus ...metav1.ConditionStatus,
 ) bool {
@@ -368,10 +694,74 @@ func (kyma *Kyma) ContainsCondition(conditionType KymaConditionType,
 	return false
 }
 
+// UpdatePausedCondition reflects the Pause of the Kyma in the Paused condition and determines if the
+// reconciliation is paused at the given time. Once a Pause expires or is removed, the condition turns False.
+func (kyma *Kyma) UpdatePausedCondition(now time.Time) bool {
+	if kyma.Spec.Pause.IsActive(now) {
+		kyma.UpdateConditionWithMessage(ConditionTypePaused, ConditionReasonReconciliationPaused, metav1.ConditionTrue,
+			kyma.Spec.Pause.String())
+		return true
+	}
+	if meta.FindStatusCondition(kyma.Status.Conditions, string(ConditionTypePaused)) != nil {
+		kyma.UpdateCondition(ConditionTypePaused, ConditionReasonReconciliationPaused, metav1.ConditionFalse)
+	}
+	return false
+}
+
+// UpdateDeprecationCondition reflects the deprecation notices of the resolved Modules
+// in the ModuleDeprecation condition. Once no Module is deprecated anymore, the condition turns False.
+func (kyma *Kyma) UpdateDeprecationCondition(notices []string) {
+	if len(notices) > 0 {
+		kyma.UpdateConditionWithMessage(ConditionTypeModuleDeprecation, ConditionReasonModuleDeprecated,
+			metav1.ConditionTrue, strings.Join(notices, "; "))
+		return
+	}
+	if meta.FindStatusCondition(kyma.Status.Conditions, string(ConditionTypeModuleDeprecation)) != nil {
+		kyma.UpdateCondition(ConditionTypeModuleDeprecation, ConditionReasonModuleDeprecated, metav1.ConditionFalse)
+	}
+}
+
+// UpdateVerificationCondition reflects the failed signature verifications of the Modules installed under the Warn
+// policy in the ModuleVerification condition. Once all Modules are verified again, the condition turns True.
+func (kyma *Kyma) UpdateVerificationCondition(warnings []string) {
+	if len(warnings) > 0 {
+		kyma.UpdateConditionWithMessage(ConditionTypeModuleVerification, ConditionReasonModulesVerified,
+			metav1.ConditionFalse, strings.Join(warnings, "; "))
+		return
+	}
+	if meta.FindStatusCondition(kyma.Status.Conditions, string(ConditionTypeModuleVerification)) != nil {
+		kyma.UpdateCondition(ConditionTypeModuleVerification, ConditionReasonModulesVerified, metav1.ConditionTrue)
+	}
+}
+
 func (kyma *Kyma) SkipReconciliation() bool {
 	return kyma.GetLabels() != nil && kyma.GetLabels()[SkipReconcileLabel] == "true"
 }
 
+// AllowsDowngradeOf determines if the Kyma is annotated to allow the downgrade of the given Module.
+func (kyma *Kyma) AllowsDowngradeOf(moduleName string) bool {
+	allowed, found := kyma.GetAnnotations()[AllowDowngradeAnnotation]
+	if !found {
+		return false
+	}
+	for _, allowedModule := range strings.Split(allowed, ",") {
+		if strings.TrimSpace(allowedModule) == moduleName {
+			return true
+		}
+	}
+	return false
+}
+
+// OrphansModules determines if the installed Modules are kept in the remote cluster when the Kyma is deleted.
+func (kyma *Kyma) OrphansModules() bool {
+	return kyma.Spec.DeletionPolicy == DeletionPolicyOrphan
+}
+
+// IsDryRun determines if the Kyma is annotated to only plan changes without applying them.
+func (kyma *Kyma) IsDryRun() bool {
+	return kyma.GetAnnotations() != nil && kyma.GetAnnotations()[DryRunAnnotation] == "true"
+}
+
 func (kyma *Kyma) DetermineState() State {
 	status := &kyma.Status
 	for _, moduleStatus := range status.Modules {
@@ -380,16 +770,9 @@ func (kyma *Kyma) DetermineState() State {
 		}
 	}
 
-	if len(status.Conditions) < 1 {
+	if !kyma.AllReadyConditionsTrue() {
 		return StateProcessing
 	}
 
-	for _, existingCondition := range status.Conditions {
-		if existingCondition.Type == string(ConditionTypeReady) &&
-			existingCondition.Status != metav1.ConditionTrue {
-			return StateProcessing
-		}
-	}
-
 	return StateReady
 }
@@ -17,8 +17,16 @@ limitations under the License.
 package v1beta1
 
 import (
+	"context"
+	"fmt"
+	"regexp"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 )
@@ -26,10 +34,13 @@ import (
 // log is for logging in this package.
 var kymalog = logf.Log.WithName("kyma-resource") //nolint:gochecknoglobals
 
+// channelPattern mirrors the validation of channels in the CRD schema.
+var channelPattern = regexp.MustCompile(`^[a-z]{3,32}$`) //nolint:gochecknoglobals
+
 func (kyma *Kyma) SetupWebhookWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewWebhookManagedBy(mgr).
-		For(kyma).
-		Complete()
+	return ctrl.NewWebhookManagedBy(mgr).WithValidator(&clusterAwareKymaValidator{
+		Client: mgr.GetClient(),
+	}).For(kyma).Complete()
 }
 
 // TODO(user): EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
@@ -45,26 +56,166 @@ func (kyma *Kyma) Default() {
 	// TODO(user): fill in your defaulting logic.
 }
 
-// TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
 //nolint:lll
 //+kubebuilder:webhook:path=/validate-operator-kyma-project-io-v1beta1-kyma,mutating=false,failurePolicy=fail,sideEffects=None,groups=operator.kyma-project.io,resources=kymas,verbs=create;update,versions=v1beta1,name=vkyma.kb.io,admissionReviewVersions=v1
 
-var _ webhook.Validator = &Kyma{}
+// clusterAwareKymaValidator rejects Kymas that could never be reconciled successfully, e.g. because they
+// reference Modules without a ModuleTemplate. Templates are looked up through the field indexes of the manager.
+type clusterAwareKymaValidator struct {
+	Client client.Reader
+}
+
+func (c *clusterAwareKymaValidator) ValidateCreate(ctx context.Context, obj runtime.Object) error {
+	kymalog.Info("validate create", "name", obj.(*Kyma).Name)
+	return c.validate(ctx, nil, obj.(*Kyma))
+}
 
-// ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
-func (kyma *Kyma) ValidateCreate() error {
-	kymalog.Info("validate create", "name", kyma.Name)
-	return nil
+func (c *clusterAwareKymaValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) error {
+	kymalog.Info("validate update", "name", newObj.(*Kyma).Name)
+	return c.validate(ctx, oldObj.(*Kyma), newObj.(*Kyma))
 }
 
-// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
-func (kyma *Kyma) ValidateUpdate(_ runtime.Object) error {
-	kymalog.Info("validate update", "name", kyma.Name)
+func (c *clusterAwareKymaValidator) ValidateDelete(_ context.Context, obj runtime.Object) error {
+	kymalog.Info("validate delete", "name", obj.(*Kyma).Name)
 	return nil
 }
 
-// ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
-func (kyma *Kyma) ValidateDelete() error {
-	kymalog.Info("validate delete", "name", kyma.Name)
+func (c *clusterAwareKymaValidator) validate(ctx context.Context, oldKyma, newKyma *Kyma) error {
+	// a Kyma in deletion always has to be updatable, otherwise its finalizers could not be removed
+	if !newKyma.GetDeletionTimestamp().IsZero() {
+		return nil
+	}
+
+	specPath := field.NewPath("spec")
+	errs := validateChannels(newKyma, specPath)
+	errs = append(errs, validateUniqueModules(newKyma, specPath.Child("modules"))...)
+	errs = append(errs, validateSync(oldKyma, newKyma, specPath.Child("sync"))...)
+
+	templateErrs, err := c.validateModuleTemplates(ctx, oldKyma, newKyma, specPath.Child("modules"))
+	if err != nil {
+		return err
+	}
+	errs = append(errs, templateErrs...)
+
+	if len(errs) > 0 {
+		return apierrors.NewInvalid(
+			schema.GroupKind{Group: GroupVersion.Group, Kind: string(KymaKind)}, newKyma.Name, errs)
+	}
 	return nil
 }
+
+func validateChannels(kyma *Kyma, specPath *field.Path) field.ErrorList {
+	const message = "channel has to consist of 3 to 32 lowercase letters"
+	var errs field.ErrorList
+	if kyma.Spec.Channel != "" && !channelPattern.MatchString(kyma.Spec.Channel) {
+		errs = append(errs, field.Invalid(specPath.Child("channel"), kyma.Spec.Channel, message))
+	}
+	for i, module := range kyma.Spec.Modules {
+		if module.Channel != "" && !channelPattern.MatchString(module.Channel) {
+			errs = append(errs, field.Invalid(
+				specPath.Child("modules").Index(i).Child("channel"), module.Channel, message))
+		}
+	}
+	return errs
+}
+
+func validateUniqueModules(kyma *Kyma, modulesPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	names := make(map[string]struct{}, len(kyma.Spec.Modules))
+	for i, module := range kyma.Spec.Modules {
+		if _, found := names[module.Name]; found {
+			errs = append(errs, field.Duplicate(modulesPath.Index(i).Child("name"), module.Name))
+		}
+		names[module.Name] = struct{}{}
+	}
+	return errs
+}
+
+func validateSync(oldKyma, newKyma *Kyma, syncPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	switch newKyma.Spec.Sync.Strategy {
+	case "", SyncStrategySecret, SyncStrategyLocalSecret, SyncStrategyLocalClient:
+	default:
+		errs = append(errs, field.NotSupported(syncPath.Child("strategy"), newKyma.Spec.Sync.Strategy,
+			[]string{SyncStrategySecret, SyncStrategyLocalSecret, SyncStrategyLocalClient}))
+	}
+
+	// the remote Kyma in the previous namespace would not be cleaned up, so the namespace has to stay stable,
+	// also while the synchronization is disabled, as it could be enabled again with another namespace
+	if oldKyma != nil && oldKyma.Spec.Sync.Namespace != newKyma.Spec.Sync.Namespace {
+		errs = append(errs, field.Forbidden(syncPath.Child("namespace"), fmt.Sprintf(
+			"cannot be changed from %q on an existing kyma", oldKyma.Spec.Sync.Namespace)))
+	}
+	return errs
+}
+
+// validateModuleTemplates verifies that a ModuleTemplate exists in the effective channel of every Module.
+// On updates, only Modules that are added or that target another channel, version or controller are verified,
+// so that the Kyma stays updatable while the templates of its installed Modules are replaced.
+func (c *clusterAwareKymaValidator) validateModuleTemplates(
+	ctx context.Context, oldKyma, newKyma *Kyma, modulesPath *field.Path,
+) (field.ErrorList, error) {
+	var errs field.ErrorList
+	for i, module := range newKyma.Spec.Modules {
+		if oldKyma != nil && !targetChanged(oldKyma, newKyma, module) {
+			continue
+		}
+		channel := effectiveChannel(newKyma, module)
+		exists, err := c.moduleTemplateExists(ctx, module, channel)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			errs = append(errs, field.Invalid(modulesPath.Index(i).Child("name"), module.Name,
+				fmt.Sprintf("no ModuleTemplate found for the module in channel %s", channel)))
+		}
+	}
+	return errs, nil
+}
+
+// moduleTemplateExists uses the same lookup variants as the resolution of templates during reconciliation:
+// the module name label, the FQDN of the descriptor and the name of the template.
+// Modules pinned to a version are resolved across all channels.
+func (c *clusterAwareKymaValidator) moduleTemplateExists(
+	ctx context.Context, module Module, channel string,
+) (bool, error) {
+	lookupVariants := []client.ListOption{
+		ModuleTemplatesByLabel(&module),
+		client.MatchingFields{ModuleTemplateFQDNField: module.Name},
+		client.MatchingFields{ModuleTemplateNameField: module.Name},
+	}
+	for _, variant := range lookupVariants {
+		templates := &ModuleTemplateList{}
+		if err := c.Client.List(ctx, templates, variant); err != nil {
+			return false, fmt.Errorf("could not list module templates for module %s: %w", module.Name, err)
+		}
+		for _, template := range templates.Items {
+			if module.Version != "" || template.Spec.Channel == channel {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func targetChanged(oldKyma, newKyma *Kyma, module Module) bool {
+	for _, oldModule := range oldKyma.Spec.Modules {
+		if oldModule.Name == module.Name {
+			return oldModule.Version != module.Version ||
+				oldModule.ControllerName != module.ControllerName ||
+				effectiveChannel(oldKyma, oldModule) != effectiveChannel(newKyma, module)
+		}
+	}
+	return true
+}
+
+func effectiveChannel(kyma *Kyma, module Module) string {
+	switch {
+	case module.Channel != "":
+		return module.Channel
+	case kyma.Spec.Channel != "":
+		return kyma.Spec.Channel
+	default:
+		return DefaultChannel
+	}
+}
@@ -0,0 +1,98 @@
+package v1beta1_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+	"github.com/kyma-project/lifecycle-manager/pkg/testutils"
+)
+
+func expectInvalid(err error, message string) {
+	Expect(err).To(HaveOccurred())
+	var statusErr *k8serrors.StatusError
+	Expect(errors.As(err, &statusErr)).To(BeTrue())
+	Expect(string(statusErr.ErrStatus.Reason)).To(Equal("Invalid"))
+	Expect(statusErr.ErrStatus.Message).To(ContainSubstring(message))
+}
+
+var _ = Describe("Kyma Webhook Validation", Ordered, func() {
+	module := v1beta1.Module{
+		ControllerName: "manifest",
+		Name:           "webhook-" + testutils.NewUniqModuleName(),
+		Channel:        v1beta1.DefaultChannel,
+	}
+	var template *v1beta1.ModuleTemplate
+
+	BeforeAll(func() {
+		var err error
+		template, err = testutils.ModuleTemplateFactory(module, unstructured.Unstructured{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(k8sClient.Create(webhookServerContext, template)).To(Succeed())
+	})
+
+	AfterAll(func() {
+		Expect(k8sClient.Delete(webhookServerContext, template)).To(Succeed())
+	})
+
+	It("should accept a kyma referencing a module with a template in its channel", func() {
+		kyma := testutils.NewTestKyma("valid")
+		kyma.Spec.Modules = append(kyma.Spec.Modules, module)
+		Eventually(k8sClient.Create, Timeout, Interval).
+			WithContext(webhookServerContext).WithArguments(kyma).Should(Succeed())
+		Expect(k8sClient.Delete(webhookServerContext, kyma)).To(Succeed())
+	})
+
+	It("should deny a kyma referencing a module without a template", func() {
+		kyma := testutils.NewTestKyma("missing-template")
+		kyma.Spec.Modules = append(kyma.Spec.Modules, v1beta1.Module{Name: "not-existing"})
+		expectInvalid(k8sClient.Create(webhookServerContext, kyma), "no ModuleTemplate found")
+	})
+
+	It("should deny a kyma referencing a module in a channel without a template", func() {
+		kyma := testutils.NewTestKyma("wrong-channel")
+		kyma.Spec.Modules = append(kyma.Spec.Modules, v1beta1.Module{Name: module.Name, Channel: "fast"})
+		expectInvalid(k8sClient.Create(webhookServerContext, kyma), "in channel fast")
+	})
+
+	It("should deny a kyma with duplicate modules", func() {
+		kyma := testutils.NewTestKyma("duplicate")
+		kyma.Spec.Modules = append(kyma.Spec.Modules, module, module)
+		expectInvalid(k8sClient.Create(webhookServerContext, kyma), "Duplicate value")
+	})
+
+	It("should deny a kyma with an unknown sync strategy", func() {
+		kyma := testutils.NewTestKyma("strategy")
+		kyma.Spec.Sync.Strategy = "unknown"
+		expectInvalid(k8sClient.Create(webhookServerContext, kyma), "spec.sync.strategy")
+	})
+
+	It("should deny switching the sync namespace of a synchronized kyma", func() {
+		kyma := testutils.NewTestKyma("namespace")
+		kyma.Spec.Sync.Enabled = true
+		kyma.Spec.Sync.Namespace = "kyma-system"
+		Expect(k8sClient.Create(webhookServerContext, kyma)).To(Succeed())
+
+		kyma.Spec.Sync.Namespace = "other"
+		expectInvalid(k8sClient.Update(webhookServerContext, kyma), "spec.sync.namespace")
+
+		Expect(k8sClient.Delete(webhookServerContext, kyma)).To(Succeed())
+	})
+
+	It("should deny switching the sync namespace while disabling the synchronization", func() {
+		kyma := testutils.NewTestKyma("namespace-disabled")
+		kyma.Spec.Sync.Enabled = true
+		kyma.Spec.Sync.Namespace = "kyma-system"
+		Expect(k8sClient.Create(webhookServerContext, kyma)).To(Succeed())
+
+		kyma.Spec.Sync.Enabled = false
+		kyma.Spec.Sync.Namespace = "other"
+		expectInvalid(k8sClient.Update(webhookServerContext, kyma), "spec.sync.namespace")
+
+		Expect(k8sClient.Delete(webhookServerContext, kyma)).To(Succeed())
+	})
+})
@@ -57,6 +57,11 @@ type ManifestSpec struct {
 	//+nullable
 	// Resource specifies a resource to be watched for state updates
 	Resource *unstructured.Unstructured `json:"resource,omitempty"`
+
+	// Pause suspends the reconciliation of the Manifest until it is removed or expires.
+	// The Manifests of a Kyma are also paused while the Kyma is paused.
+	// +optional
+	Pause *Pause `json:"pause,omitempty"`
 }
 
 // ManifestStatus defines the observed state of Manifest.
@@ -0,0 +1,102 @@
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ModuleHistoryLimit is the amount of ModuleHistoryEntry kept per Module in the status of a Kyma.
+const ModuleHistoryLimit = 10
+
+// ModuleOperation is a change to a Module that is recorded in the ModuleHistory.
+// +kubebuilder:validation:Enum=Install;Upgrade;Downgrade;ChannelSwitch;Removal
+type ModuleOperation string
+
+const (
+	ModuleOperationInstall       ModuleOperation = "Install"
+	ModuleOperationUpgrade       ModuleOperation = "Upgrade"
+	ModuleOperationDowngrade     ModuleOperation = "Downgrade"
+	ModuleOperationChannelSwitch ModuleOperation = "ChannelSwitch"
+	ModuleOperationRemoval       ModuleOperation = "Removal"
+)
+
+// ModuleOperationOutcome is the result of a ModuleOperation.
+// +kubebuilder:validation:Enum=Pending;Succeeded;Failed
+type ModuleOperationOutcome string
+
+const (
+	ModuleOperationOutcomePending   ModuleOperationOutcome = "Pending"
+	ModuleOperationOutcomeSucceeded ModuleOperationOutcome = "Succeeded"
+	ModuleOperationOutcomeFailed    ModuleOperationOutcome = "Failed"
+)
+
+// ModuleHistoryEntry records a single change to a Module of the Kyma.
+type ModuleHistoryEntry struct {
+	// Module is the name of the Module as used in the Spec.
+	Module string `json:"module"`
+
+	// Operation is the kind of change that was applied to the Module.
+	Operation ModuleOperation `json:"operation"`
+
+	// Version of the Module after the Operation.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// Channel of the Module after the Operation.
+	// +optional
+	Channel string `json:"channel,omitempty"`
+
+	// TemplateGeneration is the generation of the ModuleTemplate the Module was resolved from.
+	// +optional
+	TemplateGeneration int64 `json:"templateGeneration,omitempty"`
+
+	// Timestamp is the time at which the Operation was applied.
+	Timestamp metav1.Time `json:"timestamp"`
+
+	// Outcome is Pending until the Module becomes Ready (Succeeded) or runs into an Error (Failed)
+	// and follows the state of the Module as long as the entry is the latest one of the Module.
+	Outcome ModuleOperationOutcome `json:"outcome"`
+}
+
+// OutcomeFromState maps the State of a Module to the Outcome of its latest Operation.
+func OutcomeFromState(state State) ModuleOperationOutcome {
+	switch state {
+	case StateReady:
+		return ModuleOperationOutcomeSucceeded
+	case StateError:
+		return ModuleOperationOutcomeFailed
+	case StateProcessing, StateDeleting, "":
+	}
+	return ModuleOperationOutcomePending
+}
+
+// RecordModuleHistory appends the entry to the ModuleHistory and drops the oldest entries of the Module
+// once more than ModuleHistoryLimit entries are recorded for it.
+func (kyma *Kyma) RecordModuleHistory(entry ModuleHistoryEntry) {
+	kyma.Status.ModuleHistory = append(kyma.Status.ModuleHistory, entry)
+
+	count := 0
+	for _, recorded := range kyma.Status.ModuleHistory {
+		if recorded.Module == entry.Module {
+			count++
+		}
+	}
+	history := kyma.Status.ModuleHistory[:0]
+	for _, recorded := range kyma.Status.ModuleHistory {
+		if recorded.Module == entry.Module && count > ModuleHistoryLimit {
+			count--
+			continue
+		}
+		history = append(history, recorded)
+	}
+	kyma.Status.ModuleHistory = history
+}
+
+// UpdateModuleHistoryOutcome sets the Outcome of the latest ModuleHistoryEntry of the Module.
+func (kyma *Kyma) UpdateModuleHistoryOutcome(moduleName string, outcome ModuleOperationOutcome) {
+	for i := len(kyma.Status.ModuleHistory) - 1; i >= 0; i-- {
+		if kyma.Status.ModuleHistory[i].Module == moduleName {
+			kyma.Status.ModuleHistory[i].Outcome = outcome
+			return
+		}
+	}
+}
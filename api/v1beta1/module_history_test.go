@@ -0,0 +1,35 @@
+package v1beta1_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKyma_RecordModuleHistory(t *testing.T) {
+	t.Parallel()
+	kyma := &v1beta1.Kyma{}
+	kyma.RecordModuleHistory(v1beta1.ModuleHistoryEntry{
+		Module: "other", Operation: v1beta1.ModuleOperationInstall, Outcome: v1beta1.ModuleOperationOutcomeSucceeded,
+	})
+	for i := 0; i <= v1beta1.ModuleHistoryLimit; i++ {
+		kyma.RecordModuleHistory(v1beta1.ModuleHistoryEntry{
+			Module:    "module",
+			Operation: v1beta1.ModuleOperationUpgrade,
+			Version:   fmt.Sprintf("1.%d.0", i),
+			Outcome:   v1beta1.ModuleOperationOutcomePending,
+		})
+	}
+
+	assert.Len(t, kyma.Status.ModuleHistory, v1beta1.ModuleHistoryLimit+1)
+	assert.Equal(t, "other", kyma.Status.ModuleHistory[0].Module)
+	assert.Equal(t, "1.1.0", kyma.Status.ModuleHistory[1].Version)
+
+	kyma.UpdateModuleHistoryOutcome("module", v1beta1.OutcomeFromState(v1beta1.StateReady))
+	latest := kyma.Status.ModuleHistory[len(kyma.Status.ModuleHistory)-1]
+	assert.Equal(t, fmt.Sprintf("1.%d.0", v1beta1.ModuleHistoryLimit), latest.Version)
+	assert.Equal(t, v1beta1.ModuleOperationOutcomeSucceeded, latest.Outcome)
+	assert.Equal(t, v1beta1.ModuleOperationOutcomePending, kyma.Status.ModuleHistory[1].Outcome)
+}
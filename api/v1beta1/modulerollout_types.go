@@ -0,0 +1,110 @@
+/*
+Copyright 2022.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ModuleRollout stages the release of a new Module version within a channel. Instead of resolving the new
+// ModuleTemplate for every Kyma subscribed to the channel at once, the new version is only resolved for Kymas that
+// are part of a released wave. Kymas that are not yet part of a released wave keep resolving the previous
+// ModuleTemplate in the channel.
+//
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Module",type=string,JSONPath=".spec.module"
+// +kubebuilder:printcolumn:name="Version",type=string,JSONPath=".spec.version"
+// +kubebuilder:printcolumn:name="Wave",type=integer,JSONPath=".status.currentWave"
+// +kubebuilder:printcolumn:name="State",type=string,JSONPath=".status.state"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type ModuleRollout struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ModuleRolloutSpec   `json:"spec,omitempty"`
+	Status ModuleRolloutStatus `json:"status,omitempty"`
+}
+
+// ModuleRolloutSpec defines the desired state of ModuleRollout.
+type ModuleRolloutSpec struct {
+	// Module is the name of the Module that is rolled out, as referenced in the Modules of a Kyma.
+	Module string `json:"module"`
+
+	// Channel is the channel in which the new version of the Module is rolled out.
+	// +kubebuilder:validation:Pattern:=^[a-z]+$
+	// +kubebuilder:validation:MaxLength:=32
+	// +kubebuilder:validation:MinLength:=3
+	Channel string `json:"channel"`
+
+	// Version is the version of the ModuleTemplate in the channel that is rolled out.
+	Version string `json:"version"`
+
+	// Waves are released one after another. A wave is only released once all Modules of the Kymas
+	// in previous waves are installed in the target version and ready.
+	// +kubebuilder:validation:MinItems:=1
+	Waves []RolloutWave `json:"waves"`
+}
+
+// RolloutWave selects the Kymas that receive the new version of a Module once the wave is released.
+// A Kyma is part of the wave if it is part of the Percentage or matches the Selector.
+// Kymas of earlier waves are always part of later waves as well.
+type RolloutWave struct {
+	// Name of the wave, only used for display purposes.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Percentage of all Kymas that are part of the wave. The Kymas are selected by a stable hash of
+	// their name, so a higher percentage in a later wave always contains the Kymas of a lower one.
+	// +kubebuilder:validation:Minimum:=0
+	// +kubebuilder:validation:Maximum:=100
+	// +optional
+	Percentage *int32 `json:"percentage,omitempty"`
+
+	// Selector selects the Kymas that are part of the wave by their labels.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// ModuleRolloutStatus defines the observed state of ModuleRollout.
+type ModuleRolloutStatus struct {
+	// State signifies the current state of the ModuleRollout.
+	// It is Processing as long as waves remain to be released and Ready once the last wave is ready.
+	// +optional
+	State State `json:"state,omitempty"`
+
+	// CurrentWave is the index of the last released wave. All Kymas that are part of this
+	// or an earlier wave resolve the new version of the Module.
+	CurrentWave int `json:"currentWave"`
+
+	// +optional
+	LastOperation `json:"lastOperation,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ModuleRolloutList contains a list of ModuleRollout.
+type ModuleRolloutList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ModuleRollout `json:"items"`
+}
+
+//nolint:gochecknoinits
+func init() {
+	SchemeBuilder.Register(&ModuleRollout{}, &ModuleRolloutList{})
+}
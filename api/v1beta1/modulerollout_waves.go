@@ -0,0 +1,65 @@
+package v1beta1
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+var ErrInvalidRolloutWave = errors.New("rollout wave is invalid")
+
+const percent = 100
+
+// Targets determines if the ModuleRollout stages the given Module in the given channel.
+func (rollout *ModuleRollout) Targets(moduleName, channel string) bool {
+	return rollout.Spec.Module == moduleName && rollout.Spec.Channel == channel
+}
+
+// Releases determines if the Kyma is part of a wave that was already released.
+func (rollout *ModuleRollout) Releases(kyma *Kyma) (bool, error) {
+	return rollout.IsPartOfWaves(kyma, rollout.Status.CurrentWave)
+}
+
+// IsPartOfWaves determines if the Kyma is part of any wave up to and including the wave with the given index.
+func (rollout *ModuleRollout) IsPartOfWaves(kyma *Kyma, lastWave int) (bool, error) {
+	for i := 0; i <= lastWave && i < len(rollout.Spec.Waves); i++ {
+		contained, err := rollout.Spec.Waves[i].Contains(kyma)
+		if err != nil {
+			return false, fmt.Errorf("wave %d of rollout %s: %w", i, rollout.GetName(), err)
+		}
+		if contained {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// IsLastWave determines if the wave with the given index is the last wave of the ModuleRollout.
+func (rollout *ModuleRollout) IsLastWave(wave int) bool {
+	return wave >= len(rollout.Spec.Waves)-1
+}
+
+// Contains determines if the Kyma is part of the wave, either through its Percentage or its Selector.
+func (w RolloutWave) Contains(kyma *Kyma) (bool, error) {
+	if w.Percentage != nil && percentileOf(kyma) < int(*w.Percentage) {
+		return true, nil
+	}
+	if w.Selector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(w.Selector)
+		if err != nil {
+			return false, fmt.Errorf("%w: %s", ErrInvalidRolloutWave, err.Error())
+		}
+		return selector.Matches(labels.Set(kyma.GetLabels())), nil
+	}
+	return false, nil
+}
+
+// percentileOf assigns the Kyma a stable percentile between 0 and 99 based on the hash of its name.
+func percentileOf(kyma *Kyma) int {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(kyma.GetNamespace() + "/" + kyma.GetName()))
+	return int(hash.Sum32() % percent)
+}
@@ -0,0 +1,63 @@
+package v1beta1_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func percentage(value int32) *int32 {
+	return &value
+}
+
+func kymasInRollout(t *testing.T, rollout *v1beta1.ModuleRollout, kymas []*v1beta1.Kyma) int {
+	t.Helper()
+	released := 0
+	for _, kyma := range kymas {
+		isReleased, err := rollout.Releases(kyma)
+		assert.NoError(t, err)
+		if isReleased {
+			released++
+		}
+	}
+	return released
+}
+
+func TestModuleRollout_Releases(t *testing.T) {
+	t.Parallel()
+	kymas := make([]*v1beta1.Kyma, 1000)
+	for i := range kymas {
+		kymas[i] = &v1beta1.Kyma{}
+		kymas[i].SetName(fmt.Sprintf("kyma-%d", i))
+		kymas[i].SetNamespace("kcp-system")
+	}
+	kymas[0].SetLabels(map[string]string{"canary": "true"})
+
+	rollout := &v1beta1.ModuleRollout{Spec: v1beta1.ModuleRolloutSpec{Waves: []v1beta1.RolloutWave{
+		{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}}},
+		{Percentage: percentage(10)},
+		{Percentage: percentage(50)},
+		{Percentage: percentage(100)},
+	}}}
+
+	previouslyReleased := 0
+	for wave := range rollout.Spec.Waves {
+		rollout.Status.CurrentWave = wave
+		released := kymasInRollout(t, rollout, kymas)
+		assert.GreaterOrEqual(t, released, previouslyReleased)
+		previouslyReleased = released
+	}
+	assert.Equal(t, len(kymas), previouslyReleased)
+
+	rollout.Status.CurrentWave = 0
+	assert.Equal(t, 1, kymasInRollout(t, rollout, kymas))
+	isReleased, err := rollout.Releases(kymas[0])
+	assert.NoError(t, err)
+	assert.True(t, isReleased)
+
+	rollout.Status.CurrentWave = 2
+	assert.InDelta(t, len(kymas)/2, kymasInRollout(t, rollout, kymas), float64(len(kymas))/10)
+}
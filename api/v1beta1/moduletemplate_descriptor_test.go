@@ -0,0 +1,163 @@
+package v1beta1_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+	ocm "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1/codec/v3alpha1"
+	"github.com/kyma-project/lifecycle-manager/pkg/img"
+	"github.com/kyma-project/lifecycle-manager/pkg/signature"
+)
+
+const v3Descriptor = `{
+  "apiVersion": "ocm.software/v3alpha1",
+  "kind": "ComponentVersion",
+  "metadata": {
+    "name": "kyma-project.io/module/sample",
+    "version": "1.2.0",
+    "provider": {"name": "kyma-project.io"}
+  },
+  "repositoryContexts": [
+    {"type": "OCIRegistry", "baseUrl": "europe-docker.pkg.dev", "subPath": "kyma/modules",
+     "componentNameMapping": "urlPath"}
+  ],
+  "spec": {
+    "resources": [
+      {"name": "sample-operator", "type": "helm-chart", "relation": "local", "version": "1.2.0",
+       "access": {"type": "localBlob", "localReference": "sha256:4d1293833dcc8514528",
+        "mediaType": "application/vnd.oci.image.manifest.v1+json"},
+       "digest": {"hashAlgorithm": "SHA-256", "normalisationAlgorithm": "genericBlobDigest/v1",
+        "value": "4d1293833dcc8514528"}}
+    ]
+  },
+  "signatures": [
+    {"name": "kyma-module-signature",
+     "digest": {"hashAlgorithm": "SHA-256", "normalisationAlgorithm": "jsonNormalisation/v2",
+      "value": "deeecc1cd0ee48d5a166fa6e6ef8ad8740ccb9647700b3a7bb215782d0b4ad3f"},
+     "signature": {"algorithm": "RSASSA-PKCS1-V1_5", "mediaType": "application/vnd.ocm.signature.rsa",
+      "value": "` + v3Signature + `"}}
+  ]
+}`
+
+// v3Signature is the RSA signature of the digest of the normalised v3Descriptor, created with v3PublicKey.
+const v3Signature = "020316c00d3570e7dff1b6bd6d99d3774e1b68cb7fc559d6a475171f3e60287939d2b323ad8b26eec5a39da324481540" +
+	"6f943bca59f3d2d4ad0aba6e2e7b7190c8810c7074ac2a9948cd4cdde1544a91212e8aa4e1ec23edf6f35294cbaa5586" +
+	"51e393744404b4da8089d1559683125d140ed9ab7af8f9a3cf53a91848fd2fa22fd8dce5bcf1f1c4bdbdb5ae40727285" +
+	"e0f2963abb6c22dfe171420e8844b7988334283c20407dc38ebc2823fa7096f594b7d9c3072cedca02d5f5d384e10e1b" +
+	"3356d5a3e3f9fc126823153cf567f2bbb7d7e3fc28f15a832e079a2c784c408298146ad0b12bca85d50fc1b9fac96288" +
+	"ada35c736e40cf3e40bb0c9419f11633"
+
+const v3PublicKey = `-----BEGIN PUBLIC KEY-----
+MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAkxt6DTY+z3dRWAS58U+F
+CcceKLuRhAvnQUzNJ2Ov4rMTldcEqfBh23MACW63mLx40bA+/N3D2BBhmhn7KjJx
+wykzhlDZG+293Q8v4DFgjxu29fAam/yXEief2SbRnPI05UAj36vlbMv8xzDlJ0pu
+nOgbTkHPlztdEA6OPB9botww/LXKoHcZB7c1NGp9Gof6ug+RZL6eRh+5LD5vsSpS
+ZlAYhxSCn4QHedgCumJtUOHAWriYKmdwjM9Xvh/Jt7rccESETIYfipYzbd09VCUE
+sH0I5Khv0ChL1MkbjnsFbLnFtzqe2KP5acIysNv3pE0NlQnI7UqvuBwTDiSy+Wzr
+SQIDAQAB
+-----END PUBLIC KEY-----
+`
+
+func v3Template(descriptor string) *v1beta1.ModuleTemplate {
+	return &v1beta1.ModuleTemplate{Spec: v1beta1.ModuleTemplateSpec{
+		OCMDescriptor: runtime.RawExtension{Raw: []byte(descriptor)},
+	}}
+}
+
+func TestModuleTemplateSpec_GetDescriptorNormalisesV3(t *testing.T) {
+	t.Parallel()
+	descriptor, err := v3Template(v3Descriptor).Spec.GetDescriptor()
+	require.NoError(t, err)
+
+	assert.Equal(t, v3alpha1.SchemaVersion, descriptor.Metadata.Version)
+	assert.Equal(t, "kyma-project.io/module/sample", descriptor.GetName())
+	assert.Equal(t, "1.2.0", descriptor.GetVersion())
+	assert.Equal(t, ocm.ProviderType("kyma-project.io"), descriptor.Provider)
+	assert.Equal(t, "kyma-module-signature", descriptor.Signatures[0].Name)
+
+	repository := &ocm.OCIRegistryRepository{}
+	require.NoError(t, descriptor.GetEffectiveRepositoryContext().DecodeInto(repository))
+	assert.Equal(t, "europe-docker.pkg.dev/kyma/modules", repository.BaseURL)
+
+	layers, err := img.Parse(descriptor)
+	require.NoError(t, err)
+	require.Len(t, layers, 1)
+	oci, ok := layers[0].LayerRepresentation.(*img.OCI)
+	require.True(t, ok)
+	assert.Equal(t, "sha256:4d1293833dcc8514528", oci.Ref)
+	assert.Equal(t, "europe-docker.pkg.dev/kyma/modules/component-descriptors", oci.Repo)
+}
+
+func TestModuleTemplateSpec_VerifyV3(t *testing.T) {
+	t.Parallel()
+	publicKey, err := signature.ParsePublicKey([]byte(v3PublicKey))
+	require.NoError(t, err)
+	verifier := signature.NewMultiVerifier(map[string][]signature.Key{
+		"kyma-module-signature": {{Verifier: &signature.Verifier{PublicKey: publicKey}, Name: "v3-key"}},
+	})
+
+	tests := []struct {
+		name       string
+		descriptor string
+		expected   error
+	}{
+		{"signed descriptor", v3Descriptor, nil},
+		{"modified resource digest", strings.Replace(v3Descriptor,
+			`"value": "4d1293833dcc8514528"`, `"value": "0000000000000000000"`, 1), signature.ErrDigestMismatch},
+		{"unsigned label added", strings.Replace(v3Descriptor,
+			`"provider": {"name": "kyma-project.io"}`,
+			`"provider": {"name": "kyma-project.io"}, "labels": [{"name": "team", "value": "kyma"}]`, 1), nil},
+		{"signed label added", strings.Replace(v3Descriptor,
+			`"provider": {"name": "kyma-project.io"}`,
+			`"provider": {"name": "kyma-project.io"}, "labels": [{"name": "team", "value": "kyma", "signing": true}]`,
+			1), signature.ErrDigestMismatch},
+	}
+	for _, testCase := range tests {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			template := v3Template(testCase.descriptor)
+			descriptor, err := template.Spec.GetDescriptor()
+			require.NoError(t, err)
+
+			key, err := verifier.VerifyWithKey(*descriptor, template.Spec.OCMDescriptor.Raw, descriptor.Signatures[0])
+			if testCase.expected == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, "v3-key", key)
+			} else {
+				assert.ErrorIs(t, err, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestModuleTemplateSpec_GetDescriptorRejectsInvalidV3(t *testing.T) {
+	t.Parallel()
+	invalid := `{"apiVersion": "ocm.software/v3alpha1", "kind": "ComponentVersion",
+		"metadata": {"name": "kyma-project.io/module/sample", "version": "1.2.0"}}`
+
+	_, err := v3Template(invalid).Spec.GetDescriptor()
+	assert.ErrorIs(t, err, v3alpha1.ErrInvalidDescriptor)
+	assert.ErrorContains(t, err, "metadata.provider.name")
+}
+
+func TestModuleTemplateSpec_ModifyDescriptorKeepsV3Schema(t *testing.T) {
+	t.Parallel()
+	template := v3Template(v3Descriptor)
+	require.NoError(t, template.Spec.ModifyDescriptor(v1beta1.ModifyDescriptorVersion(
+		func(version *semver.Version) string { return version.IncMinor().String() },
+	)))
+
+	assert.True(t, v3alpha1.IsV3(template.Spec.OCMDescriptor.Raw))
+	descriptor, err := template.Spec.GetDescriptor()
+	require.NoError(t, err)
+	assert.Equal(t, "1.3.0", descriptor.GetVersion())
+	assert.Equal(t, ocm.LocalOCIBlobType, descriptor.Resources[0].Access.GetType())
+}
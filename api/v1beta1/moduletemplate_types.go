@@ -23,6 +23,7 @@ import (
 	ocm "github.com/gardener/component-spec/bindings-go/apis/v2"
 	"github.com/gardener/component-spec/bindings-go/codec"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta1/codec/unsafe"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1/codec/v3alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -34,13 +35,21 @@ import (
 //
 // +genclient
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Channel",type=string,JSONPath=".spec.channel"
+// +kubebuilder:printcolumn:name="Module",type=string,JSONPath=".status.descriptor.name"
+// +kubebuilder:printcolumn:name="Version",type=string,JSONPath=".status.descriptor.version"
+// +kubebuilder:printcolumn:name="Schema",type=string,JSONPath=".status.descriptor.schemaVersion",priority=1
+// +kubebuilder:printcolumn:name="Used By",type=integer,JSONPath=".status.usedByCount"
+// +kubebuilder:printcolumn:name="Newer Template",type=string,JSONPath=".status.newerTemplate"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:storageversion
 type ModuleTemplate struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec ModuleTemplateSpec `json:"spec,omitempty"`
+	Spec   ModuleTemplateSpec   `json:"spec,omitempty"`
+	Status ModuleTemplateStatus `json:"status,omitempty"`
 }
 
 // ModuleTemplateSpec defines the desired state of ModuleTemplate.
@@ -80,6 +89,17 @@ type ModuleTemplateSpec struct {
 	// hint by downstream controllers to determine which client implementation to use for working with the Module
 	Target Target `json:"target"`
 
+	// Dependencies is a list of Modules that have to be installed and ready before this Module is installed.
+	// Each entry refers to another Module of the same Kyma, either by its Name in the Kyma specification
+	// or by its FQDN. Modules are uninstalled in the reverse order of their dependencies.
+	// +optional
+	Dependencies []string `json:"dependencies,omitempty"`
+
+	// Deprecation marks the Module version of this ModuleTemplate in its channel as deprecated.
+	// Kymas resolving the ModuleTemplate get a warning and fail once its removal date has passed.
+	// +optional
+	Deprecation *Deprecation `json:"deprecation,omitempty"`
+
 	// descriptor is the internal reference holder of the OCMDescriptor once parsed.
 	// it is purposefully not exposed and also excluded from parsers and only used
 	// by GetUnsafeDescriptor to hold a singleton reference to avoid multiple parse efforts
@@ -87,10 +107,16 @@ type ModuleTemplateSpec struct {
 	descriptor *ocm.ComponentDescriptor `json:"-"`
 }
 
+// GetUnsafeDescriptor decodes the OCMDescriptor without validating it. Descriptors in the v3alpha1 schema
+// are normalised into the v2 model, so that callers do not have to care about the schema of the descriptor.
 func (in *ModuleTemplateSpec) GetUnsafeDescriptor() (*ocm.ComponentDescriptor, error) {
 	if in.descriptor == nil && in.OCMDescriptor.Raw != nil {
+		decode := unsafe.DecodeV2
+		if v3alpha1.IsV3(in.OCMDescriptor.Raw) {
+			decode = unsafe.DecodeV3
+		}
 		var descriptor ocm.ComponentDescriptor
-		if err := unsafe.DecodeV2(in.OCMDescriptor.Raw, &descriptor); err != nil {
+		if err := decode(in.OCMDescriptor.Raw, &descriptor); err != nil {
 			return nil, err
 		}
 		in.descriptor = &descriptor
@@ -98,10 +124,16 @@ func (in *ModuleTemplateSpec) GetUnsafeDescriptor() (*ocm.ComponentDescriptor, e
 	return in.descriptor, nil
 }
 
+// GetDescriptor decodes and validates the OCMDescriptor against its schema.
+// Like GetUnsafeDescriptor, it normalises descriptors in the v3alpha1 schema into the v2 model.
 func (in *ModuleTemplateSpec) GetDescriptor() (*ocm.ComponentDescriptor, error) {
 	if in.descriptor == nil && in.OCMDescriptor.Raw != nil {
 		var descriptor ocm.ComponentDescriptor
-		if err := codec.Decode(in.OCMDescriptor.Raw, &descriptor); err != nil {
+		if v3alpha1.IsV3(in.OCMDescriptor.Raw) {
+			if err := v3alpha1.Decode(in.OCMDescriptor.Raw, &descriptor); err != nil {
+				return nil, err
+			}
+		} else if err := codec.Decode(in.OCMDescriptor.Raw, &descriptor); err != nil {
 			return nil, err
 		}
 		in.descriptor = &descriptor
@@ -119,7 +151,11 @@ func (in *ModuleTemplateSpec) ModifyDescriptor(modify func(descriptor *ocm.Compo
 		return err
 	}
 
-	encodedDescriptor, err := unsafe.EncodeV2(descriptor)
+	encode := unsafe.EncodeV2
+	if v3alpha1.IsNormalised(descriptor) {
+		encode = unsafe.EncodeV3
+	}
+	encodedDescriptor, err := encode(descriptor)
 	if err != nil {
 		return err
 	}
@@ -146,6 +182,91 @@ func ModifyDescriptorVersion(
 	}
 }
 
+// ModuleTemplateUsedByLimit is the maximum amount of Kymas listed in the status of a ModuleTemplate.
+const ModuleTemplateUsedByLimit = 20
+
+// ModuleTemplateStatus defines the observed state of ModuleTemplate. It shows module owners which Kymas
+// would be affected by an update of the template and whether its descriptor can be installed.
+type ModuleTemplateStatus struct {
+	// Descriptor identifies the Module of the descriptor independent of the schema it was published in.
+	// +optional
+	Descriptor *TemplateDescriptor `json:"descriptor,omitempty"`
+
+	// UsedByCount is the number of Kymas that resolved the ModuleTemplate for one of their Modules.
+	UsedByCount int `json:"usedByCount"`
+
+	// UsedBy lists the Kymas that resolved the ModuleTemplate as namespace/name,
+	// limited to the first ModuleTemplateUsedByLimit Kymas in alphabetical order.
+	// +optional
+	UsedBy []string `json:"usedBy,omitempty"`
+
+	// Verification is the result of the last signature verification of the descriptor.
+	// +optional
+	Verification *TemplateVerification `json:"verification,omitempty"`
+
+	// Layers are the layers parsed from the descriptor, which are translated into the Manifest of a Module.
+	// +optional
+	Layers []TemplateLayer `json:"layers,omitempty"`
+
+	// NewerTemplate is the name of the ModuleTemplate with the highest version of the same Module in the same
+	// channel, if its version is higher than the version of this ModuleTemplate.
+	// +optional
+	NewerTemplate string `json:"newerTemplate,omitempty"`
+
+	// ObservedGeneration is the generation of the ModuleTemplate the status was computed for.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// TemplateDescriptor identifies the Module of a descriptor.
+type TemplateDescriptor struct {
+	// Name is the FQDN of the Module.
+	Name string `json:"name"`
+	// Version is the version of the Module.
+	Version string `json:"version"`
+	// SchemaVersion is the schema the descriptor was published in, either v2 or ocm.software/v3alpha1.
+	SchemaVersion string `json:"schemaVersion"`
+}
+
+type TemplateVerificationResult string
+
+const (
+	TemplateVerificationSucceeded TemplateVerificationResult = "Succeeded"
+	TemplateVerificationFailed    TemplateVerificationResult = "Failed"
+	TemplateVerificationDisabled  TemplateVerificationResult = "Disabled"
+)
+
+// TemplateVerification is the result of a signature verification of the descriptor of a ModuleTemplate.
+type TemplateVerification struct {
+	// +kubebuilder:validation:Enum=Succeeded;Failed;Disabled
+	Result TemplateVerificationResult `json:"result"`
+
+	// Message explains why the verification failed.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Key is the name of the key that verified the descriptor, e.g. the name of its Secret,
+	// so that keys no longer in use can be retired after a key rotation.
+	// +optional
+	Key string `json:"key,omitempty"`
+
+	// LastVerificationTime is the time of the last verification of the descriptor.
+	// The descriptor is verified again on every reconciliation of the ModuleTemplate.
+	LastVerificationTime metav1.Time `json:"lastVerificationTime"`
+}
+
+// TemplateLayer describes a layer parsed from the descriptor of a ModuleTemplate.
+type TemplateLayer struct {
+	// Name is the name of the resource in the descriptor the layer was parsed from.
+	Name string `json:"name"`
+
+	// Type is the representation of the layer, e.g. oci-ref or helm-chart.
+	Type string `json:"type"`
+
+	// Ref is the location the layer is pulled from.
+	Ref string `json:"ref"`
+}
+
 //+kubebuilder:object:root=true
 
 // ModuleTemplateList contains a list of ModuleTemplate.
@@ -164,6 +285,13 @@ const (
 	TargetControlPlane Target = "control-plane"
 )
 
+// Field paths under which ModuleTemplates are indexed in the cache of the manager, see pkg/index.
+const (
+	ModuleTemplateChannelField = "spec.channel"
+	ModuleTemplateFQDNField    = "spec.descriptor.component.name"
+	ModuleTemplateNameField    = "metadata.name"
+)
+
 //nolint:gochecknoinits
 func init() {
 	SchemeBuilder.Register(&ModuleTemplate{}, &ModuleTemplateList{})
@@ -0,0 +1,85 @@
+package v1beta1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	ocm "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var errUntrusted = errors.New("untrusted")
+
+func validTemplate() *v1beta1.ModuleTemplate {
+	template := &v1beta1.ModuleTemplate{}
+	template.Spec.Target = v1beta1.TargetRemote
+	template.Spec.Data = unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "operator.kyma-project.io/v1alpha1",
+		"kind":       "Sample",
+	}}
+	return template
+}
+
+//nolint:funlen
+func TestValidateModuleTemplate(t *testing.T) {
+	t.Parallel()
+	untrusted := func(context.Context, *v1beta1.ModuleTemplate, *ocm.ComponentDescriptor) error {
+		return errUntrusted
+	}
+	tests := []struct {
+		name        string
+		modify      func(template *v1beta1.ModuleTemplate)
+		validations []v1beta1.DescriptorValidation
+		expected    []string
+	}{
+		{
+			"valid template",
+			func(template *v1beta1.ModuleTemplate) {},
+			nil,
+			nil,
+		},
+		{
+			"template without data",
+			func(template *v1beta1.ModuleTemplate) { template.Spec.Data = unstructured.Unstructured{} },
+			nil,
+			nil,
+		},
+		{
+			"invalid target",
+			func(template *v1beta1.ModuleTemplate) { template.Spec.Target = "" },
+			nil,
+			[]string{"spec.target"},
+		},
+		{
+			"data without apiVersion and kind",
+			func(template *v1beta1.ModuleTemplate) {
+				template.Spec.Data = unstructured.Unstructured{Object: map[string]any{"spec": "value"}}
+			},
+			nil,
+			[]string{"spec.data.apiVersion", "spec.data.kind"},
+		},
+		{
+			"failing descriptor validation",
+			func(template *v1beta1.ModuleTemplate) {},
+			[]v1beta1.DescriptorValidation{untrusted},
+			[]string{"spec.descriptor"},
+		},
+	}
+	for _, testCase := range tests {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			template := validTemplate()
+			testCase.modify(template)
+			errs := v1beta1.ValidateModuleTemplate(
+				context.TODO(), template, &ocm.ComponentDescriptor{}, testCase.validations)
+			assert.Len(t, errs, len(testCase.expected))
+			for i, expected := range testCase.expected {
+				assert.Equal(t, expected, errs[i].Field)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	"github.com/Masterminds/semver/v3"
+	ocm "github.com/gardener/component-spec/bindings-go/apis/v2"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -47,16 +48,26 @@ func (in *ModuleTemplate) Default() {
 // log is for logging in this package.
 var moduletemplatelog = logf.Log.WithName("moduletemplate-resource") //nolint:gochecknoglobals
 
+// DescriptorValidation checks the descriptor of a ModuleTemplate during admission. Checks that rely on packages
+// depending on this API, such as parsing the layers or verifying the signatures, are passed in this form
+// when setting up the webhook.
+// +kubebuilder:object:generate=false
+type DescriptorValidation func(
+	ctx context.Context, template *ModuleTemplate, descriptor *ocm.ComponentDescriptor,
+) error
+
 func (in *ModuleTemplate) SetupWebhookWithManager(
-	mgr ctrl.Manager,
+	mgr ctrl.Manager, descriptorValidations ...DescriptorValidation,
 ) error {
 	return ctrl.NewWebhookManagedBy(mgr).WithValidator(&clusterAwareModuleTemplateValidator{
-		Client: mgr.GetClient(),
+		Client:                mgr.GetClient(),
+		DescriptorValidations: descriptorValidations,
 	}).For(in).Complete()
 }
 
 type clusterAwareModuleTemplateValidator struct {
-	Client client.Client
+	Client                client.Client
+	DescriptorValidations []DescriptorValidation
 }
 
 func (c *clusterAwareModuleTemplateValidator) ValidateCreate(ctx context.Context, obj runtime.Object) error {
@@ -75,7 +86,7 @@ func (c *clusterAwareModuleTemplateValidator) ValidateDelete(ctx context.Context
 }
 
 func (c *clusterAwareModuleTemplateValidator) validate(
-	_ context.Context, oldTemplate, newTemplate *ModuleTemplate,
+	ctx context.Context, oldTemplate, newTemplate *ModuleTemplate,
 ) error {
 	newDescriptor, err := newTemplate.Spec.GetDescriptor()
 	if err != nil {
@@ -111,5 +122,47 @@ func (c *clusterAwareModuleTemplateValidator) validate(
 		}
 	}
 
+	if errs := ValidateModuleTemplate(ctx, newTemplate, newDescriptor, c.DescriptorValidations); len(errs) > 0 {
+		return apierrors.NewInvalid(
+			schema.GroupKind{Group: GroupVersion.Group, Kind: "ModuleTemplate"}, newTemplate.Name, errs)
+	}
+
 	return nil
 }
+
+// ValidateModuleTemplate reports the fields of a ModuleTemplate that would prevent it from being translated
+// into a Manifest, so that they are rejected when the template is published instead of when a Kyma uses it.
+func ValidateModuleTemplate(
+	ctx context.Context, template *ModuleTemplate, descriptor *ocm.ComponentDescriptor,
+	descriptorValidations []DescriptorValidation,
+) field.ErrorList {
+	specPath := field.NewPath("spec")
+	var errs field.ErrorList
+
+	switch template.Spec.Target {
+	case TargetRemote, TargetControlPlane:
+	default:
+		errs = append(errs, field.NotSupported(specPath.Child("target"), template.Spec.Target,
+			[]string{string(TargetRemote), string(TargetControlPlane)}))
+	}
+
+	// templates without data are allowed, their modules are installed without a default resource
+	if data := template.Spec.Data; len(data.Object) > 0 {
+		if data.GetAPIVersion() == "" {
+			errs = append(errs, field.Required(specPath.Child("data").Child("apiVersion"),
+				"the default resource needs an apiVersion"))
+		}
+		if data.GetKind() == "" {
+			errs = append(errs, field.Required(specPath.Child("data").Child("kind"),
+				"the default resource needs a kind"))
+		}
+	}
+
+	for _, validate := range descriptorValidations {
+		if err := validate(ctx, template, descriptor); err != nil {
+			errs = append(errs, field.Invalid(specPath.Child("descriptor"), descriptor.GetName(), err.Error()))
+		}
+	}
+
+	return errs
+}
@@ -3,4 +3,20 @@ package v1beta1
 const (
 	LastSync = OperatorPrefix + Separator + "last-sync"
 	FQDN     = OperatorPrefix + Separator + "fqdn"
+	// DryRunAnnotation set to "true" makes the Kyma and Manifest reconcilers plan their changes
+	// and write them into the status instead of applying them.
+	DryRunAnnotation = OperatorPrefix + Separator + "dry-run"
+	// AllowDowngradeAnnotation contains a comma separated list of Module names that are allowed to be
+	// downgraded to a lower version, overriding the downgrade protection for an intentional rollback.
+	AllowDowngradeAnnotation = OperatorPrefix + Separator + "allow-downgrade"
+	// OrphanResourcesAnnotation set to "true" makes the Manifest reconciler keep the resources of a Manifest
+	// in the remote cluster when the Manifest is deleted.
+	OrphanResourcesAnnotation = OperatorPrefix + Separator + "orphan-resources"
+	// SignatureNotBeforeAnnotation and SignatureNotAfterAnnotation limit the period a signature key in a Secret
+	// is used for verification, both in RFC 3339 format, so that keys can be rotated without downtime.
+	SignatureNotBeforeAnnotation = OperatorPrefix + Separator + "signature-not-before"
+	SignatureNotAfterAnnotation  = OperatorPrefix + Separator + "signature-not-after"
+	// VerificationPolicyAnnotation on a Namespace sets the VerificationPolicy of all Kymas in the Namespace
+	// that do not set a policy themselves.
+	VerificationPolicyAnnotation = OperatorPrefix + Separator + "verification-policy"
 )
@@ -0,0 +1,50 @@
+package v1beta1
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Pause suspends the reconciliation of a resource until it is removed or expires.
+// In contrast to the SkipReconcileLabel, it records why and by whom the reconciliation was paused.
+// The deletion of a resource is never paused.
+type Pause struct {
+	// Reason explains why the reconciliation is paused, e.g. a reference to an incident.
+	// +kubebuilder:validation:MinLength:=1
+	Reason string `json:"reason"`
+
+	// PausedBy identifies the actor who paused the reconciliation.
+	// +optional
+	PausedBy string `json:"pausedBy,omitempty"`
+
+	// Until is the time at which the pause expires and the reconciliation resumes automatically.
+	// If it is not set, the reconciliation stays paused until the pause is removed.
+	// +optional
+	Until *metav1.Time `json:"until,omitempty"`
+}
+
+// IsActive determines if the reconciliation is paused at the given time.
+func (p *Pause) IsActive(now time.Time) bool {
+	return p != nil && (p.Until == nil || now.Before(p.Until.Time))
+}
+
+// Expiry returns the time at which the pause expires or the zero time if it does not expire.
+func (p *Pause) Expiry() time.Time {
+	if p == nil || p.Until == nil {
+		return time.Time{}
+	}
+	return p.Until.Time
+}
+
+func (p *Pause) String() string {
+	message := GenerateMessage(ConditionReasonReconciliationPaused, metav1.ConditionTrue)
+	if p.PausedBy != "" {
+		message += " by " + p.PausedBy
+	}
+	if p.Until != nil {
+		message += " until " + p.Until.UTC().Format(time.RFC3339)
+	}
+	return fmt.Sprintf("%s: %s", message, p.Reason)
+}
@@ -33,6 +33,7 @@ import (
 
 	"github.com/kyma-project/lifecycle-manager/api"
 	"github.com/kyma-project/lifecycle-manager/api/v1alpha1"
+	"github.com/kyma-project/lifecycle-manager/pkg/index"
 	"github.com/kyma-project/lifecycle-manager/pkg/log"
 	"go.uber.org/zap/zapcore"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -120,6 +121,12 @@ func SetupWebhook() {
 
 	Expect((&v1beta1.ModuleTemplate{}).SetupWebhookWithManager(mgr)).NotTo(HaveOccurred())
 	Expect((&v1alpha1.ModuleTemplate{}).SetupWebhookWithManager(mgr)).NotTo(HaveOccurred())
+	Expect((&v1beta1.Kyma{}).SetupWebhookWithManager(mgr)).NotTo(HaveOccurred())
+
+	// the kyma webhook resolves module templates through the same field indexes as the kyma controller
+	Expect(index.TemplateChannel().With(webhookServerContext, mgr.GetFieldIndexer())).To(Succeed())
+	Expect(index.TemplateFQDN().With(webhookServerContext, mgr.GetFieldIndexer())).To(Succeed())
+	Expect(index.TemplateName().With(webhookServerContext, mgr.GetFieldIndexer())).To(Succeed())
 
 	//+kubebuilder:scaffold:webhook
 
@@ -43,6 +43,25 @@ func (in *ConditionBuilder) DeepCopy() *ConditionBuilder {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Deprecation) DeepCopyInto(out *Deprecation) {
+	*out = *in
+	if in.RemovalDate != nil {
+		in, out := &in.RemovalDate, &out.RemovalDate
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Deprecation.
+func (in *Deprecation) DeepCopy() *Deprecation {
+	if in == nil {
+		return nil
+	}
+	out := new(Deprecation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GatewayConfig) DeepCopyInto(out *GatewayConfig) {
 	*out = *in
@@ -184,15 +203,57 @@ func (in *KymaList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KymaPlan) DeepCopyInto(out *KymaPlan) {
+	*out = *in
+	if in.Create != nil {
+		in, out := &in.Create, &out.Create
+		*out = make([]PlannedModule, len(*in))
+		copy(*out, *in)
+	}
+	if in.Update != nil {
+		in, out := &in.Update, &out.Update
+		*out = make([]PlannedModule, len(*in))
+		copy(*out, *in)
+	}
+	if in.Prune != nil {
+		in, out := &in.Prune, &out.Prune
+		*out = make([]PlannedModule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KymaPlan.
+func (in *KymaPlan) DeepCopy() *KymaPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(KymaPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KymaSpec) DeepCopyInto(out *KymaSpec) {
 	*out = *in
 	if in.Modules != nil {
 		in, out := &in.Modules, &out.Modules
 		*out = make([]Module, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	out.Sync = in.Sync
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(Maintenance)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Pause != nil {
+		in, out := &in.Pause, &out.Pause
+		*out = new(Pause)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KymaSpec.
@@ -218,7 +279,21 @@ func (in *KymaStatus) DeepCopyInto(out *KymaStatus) {
 	if in.Modules != nil {
 		in, out := &in.Modules, &out.Modules
 		*out = make([]ModuleStatus, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(KymaPlan)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ModuleHistory != nil {
+		in, out := &in.ModuleHistory, &out.ModuleHistory
+		*out = make([]ModuleHistoryEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	in.LastOperation.DeepCopyInto(&out.LastOperation)
 }
@@ -249,6 +324,48 @@ func (in *LastOperation) DeepCopy() *LastOperation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Maintenance) DeepCopyInto(out *Maintenance) {
+	*out = *in
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]MaintenanceWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Maintenance.
+func (in *Maintenance) DeepCopy() *Maintenance {
+	if in == nil {
+		return nil
+	}
+	out := new(Maintenance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]Weekday, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Manifest) DeepCopyInto(out *Manifest) {
 	*out = *in
@@ -317,6 +434,11 @@ func (in *ManifestSpec) DeepCopyInto(out *ManifestSpec) {
 		in, out := &in.Resource, &out.Resource
 		*out = (*in).DeepCopy()
 	}
+	if in.Pause != nil {
+		in, out := &in.Pause, &out.Pause
+		*out = new(Pause)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestSpec.
@@ -344,6 +466,11 @@ func (in *ManifestStatus) DeepCopyInto(out *ManifestStatus) {
 		*out = make([]v2.Resource, len(*in))
 		copy(*out, *in)
 	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(v2.Plan)
+		(*in).DeepCopyInto(*out)
+	}
 	in.LastOperation.DeepCopyInto(&out.LastOperation)
 }
 
@@ -360,6 +487,11 @@ func (in *ManifestStatus) DeepCopy() *ManifestStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Module) DeepCopyInto(out *Module) {
 	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Module.
@@ -372,11 +504,128 @@ func (in *Module) DeepCopy() *Module {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleHistoryEntry) DeepCopyInto(out *ModuleHistoryEntry) {
+	*out = *in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleHistoryEntry.
+func (in *ModuleHistoryEntry) DeepCopy() *ModuleHistoryEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleHistoryEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleRollout) DeepCopyInto(out *ModuleRollout) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleRollout.
+func (in *ModuleRollout) DeepCopy() *ModuleRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ModuleRollout) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleRolloutList) DeepCopyInto(out *ModuleRolloutList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ModuleRollout, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleRolloutList.
+func (in *ModuleRolloutList) DeepCopy() *ModuleRolloutList {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleRolloutList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ModuleRolloutList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleRolloutSpec) DeepCopyInto(out *ModuleRolloutSpec) {
+	*out = *in
+	if in.Waves != nil {
+		in, out := &in.Waves, &out.Waves
+		*out = make([]RolloutWave, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleRolloutSpec.
+func (in *ModuleRolloutSpec) DeepCopy() *ModuleRolloutSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleRolloutSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleRolloutStatus) DeepCopyInto(out *ModuleRolloutStatus) {
+	*out = *in
+	in.LastOperation.DeepCopyInto(&out.LastOperation)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleRolloutStatus.
+func (in *ModuleRolloutStatus) DeepCopy() *ModuleRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ModuleStatus) DeepCopyInto(out *ModuleStatus) {
 	*out = *in
 	out.Manifest = in.Manifest
 	out.Template = in.Template
+	if in.DeletionTriggeredAt != nil {
+		in, out := &in.DeletionTriggeredAt, &out.DeletionTriggeredAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleStatus.
@@ -395,6 +644,7 @@ func (in *ModuleTemplate) DeepCopyInto(out *ModuleTemplate) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleTemplate.
@@ -452,6 +702,16 @@ func (in *ModuleTemplateSpec) DeepCopyInto(out *ModuleTemplateSpec) {
 	*out = *in
 	in.Data.DeepCopyInto(&out.Data)
 	in.OCMDescriptor.DeepCopyInto(&out.OCMDescriptor)
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Deprecation != nil {
+		in, out := &in.Deprecation, &out.Deprecation
+		*out = new(Deprecation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.descriptor != nil {
 		in, out := &in.descriptor, &out.descriptor
 		*out = new(apisv2.ComponentDescriptor)
@@ -469,6 +729,41 @@ func (in *ModuleTemplateSpec) DeepCopy() *ModuleTemplateSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModuleTemplateStatus) DeepCopyInto(out *ModuleTemplateStatus) {
+	*out = *in
+	if in.Descriptor != nil {
+		in, out := &in.Descriptor, &out.Descriptor
+		*out = new(TemplateDescriptor)
+		**out = **in
+	}
+	if in.UsedBy != nil {
+		in, out := &in.UsedBy, &out.UsedBy
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(TemplateVerification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Layers != nil {
+		in, out := &in.Layers, &out.Layers
+		*out = make([]TemplateLayer, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModuleTemplateStatus.
+func (in *ModuleTemplateStatus) DeepCopy() *ModuleTemplateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ModuleTemplateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PartialMeta) DeepCopyInto(out *PartialMeta) {
 	*out = *in
@@ -484,6 +779,65 @@ func (in *PartialMeta) DeepCopy() *PartialMeta {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Pause) DeepCopyInto(out *Pause) {
+	*out = *in
+	if in.Until != nil {
+		in, out := &in.Until, &out.Until
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Pause.
+func (in *Pause) DeepCopy() *Pause {
+	if in == nil {
+		return nil
+	}
+	out := new(Pause)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlannedModule) DeepCopyInto(out *PlannedModule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlannedModule.
+func (in *PlannedModule) DeepCopy() *PlannedModule {
+	if in == nil {
+		return nil
+	}
+	out := new(PlannedModule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutWave) DeepCopyInto(out *RolloutWave) {
+	*out = *in
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutWave.
+func (in *RolloutWave) DeepCopy() *RolloutWave {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutWave)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Service) DeepCopyInto(out *Service) {
 	*out = *in
@@ -514,6 +868,52 @@ func (in *Sync) DeepCopy() *Sync {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplateDescriptor) DeepCopyInto(out *TemplateDescriptor) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateDescriptor.
+func (in *TemplateDescriptor) DeepCopy() *TemplateDescriptor {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplateDescriptor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplateLayer) DeepCopyInto(out *TemplateLayer) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateLayer.
+func (in *TemplateLayer) DeepCopy() *TemplateLayer {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplateLayer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplateVerification) DeepCopyInto(out *TemplateVerification) {
+	*out = *in
+	in.LastVerificationTime.DeepCopyInto(&out.LastVerificationTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateVerification.
+func (in *TemplateVerification) DeepCopy() *TemplateVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplateVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrackingObject) DeepCopyInto(out *TrackingObject) {
 	*out = *in
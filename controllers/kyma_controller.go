@@ -20,16 +20,23 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/kyma-project/lifecycle-manager/pkg/log"
 	"k8s.io/client-go/rest"
 
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
 	"github.com/kyma-project/lifecycle-manager/pkg/adapter"
 	"github.com/kyma-project/lifecycle-manager/pkg/channel"
+	"github.com/kyma-project/lifecycle-manager/pkg/metrics"
 	"github.com/kyma-project/lifecycle-manager/pkg/module/common"
 	"github.com/kyma-project/lifecycle-manager/pkg/module/parse"
 	"github.com/kyma-project/lifecycle-manager/pkg/module/sync"
@@ -51,6 +58,9 @@ const (
 	ModuleReconciliationError EventErrorType = "ModuleReconciliationError"
 	SyncContextError          EventErrorType = "SyncContextError"
 	DeletionError             EventErrorType = "DeletionError"
+	ModuleDowngradeBlocked    EventErrorType = "ModuleDowngradeBlocked"
+	ModuleDeprecated          EventErrorType = "ModuleDeprecated"
+	ModuleUnverified          EventErrorType = "ModuleUnverified"
 )
 
 type RequeueIntervals struct {
@@ -66,6 +76,11 @@ type KymaReconciler struct {
 	SKRWebhookManager watcher.SKRWebhookManager
 	KcpRestConfig     *rest.Config
 	RemoteClientCache *remote.ClientCache
+	// DowngradePolicy determines if Modules may be downgraded. Downgrades are blocked unless set to Allow.
+	DowngradePolicy common.DowngradePolicy
+	// TemplateResolutionStrategy determines how a ModuleTemplate is resolved if several ModuleTemplates of a Module
+	// are assigned to the same channel and the Module does not declare a strategy itself.
+	TemplateResolutionStrategy v1beta1.TemplateResolutionStrategy
 }
 
 //nolint:lll
@@ -75,9 +90,11 @@ type KymaReconciler struct {
 //+kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch
 //+kubebuilder:rbac:groups="",resources=events,verbs=create;patch;get;list;watch
 //+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
 //+kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=operator.kyma-project.io,resources=moduletemplates,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=operator.kyma-project.io,resources=moduletemplates/finalizers,verbs=update
+//+kubebuilder:rbac:groups=operator.kyma-project.io,resources=modulerollouts,verbs=get;list;watch
 //+kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch
 //+kubebuilder:rbac:groups=cert-manager.io,resources=issuers,verbs=get;list;watch
 //+kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;create;update;delete;patch
@@ -98,6 +115,7 @@ func (r *KymaReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 		// requeue (we'll need to wait for a new notification), and we can get them
 		// on deleted requests.
 		logger.Info("Deleted successfully!")
+		metrics.RemoveKymaMetrics(req.NamespacedName)
 
 		return ctrl.Result{}, client.IgnoreNotFound(err) //nolint:wrapcheck
 	}
@@ -107,12 +125,25 @@ func (r *KymaReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 		return ctrl.Result{RequeueAfter: r.RequeueIntervals.Success}, nil
 	}
 
+	// a pause never holds back the deletion of the kyma
+	var previousPausedCondition metav1.Condition
+	pausedCondition := meta.FindStatusCondition(kyma.Status.Conditions, string(v1beta1.ConditionTypePaused))
+	if pausedCondition != nil {
+		previousPausedCondition = *pausedCondition
+	}
+	if kyma.DeletionTimestamp.IsZero() && kyma.UpdatePausedCondition(time.Now()) {
+		return r.pauseReconciliation(ctx, kyma, previousPausedCondition)
+	}
+
 	if kyma.Spec.Sync.Enabled {
 		var err error
 		if ctx, err = remote.InitializeSyncContext(ctx, kyma,
 			remote.NewClientWithConfig(r.Client, r.KcpRestConfig), r.RemoteClientCache); err != nil {
 			err := fmt.Errorf("initializing sync context failed: %w", err)
 			r.Event(kyma, "Warning", string(SyncContextError), err.Error())
+			metrics.RecordRemoteSyncFailure(kyma)
+			kyma.UpdateCondition(v1beta1.ConditionTypeRemoteSync,
+				v1beta1.ConditionReasonRemoteKymaIsSynced, metav1.ConditionFalse)
 			return r.CtrlErr(ctx, kyma, err)
 		}
 	}
@@ -130,17 +161,50 @@ func (r *KymaReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 		return ctrl.Result{}, nil
 	}
 
+	// in dry-run mode, we only plan the changes to the modules without touching the remote cluster
+	if kyma.IsDryRun() && kyma.DeletionTimestamp.IsZero() {
+		return r.planModules(ctx, kyma)
+	}
+	kyma.Status.Plan = nil
+
 	// create a remote synchronization context, and update the remote kyma with the state of the control plane
 	if kyma.Spec.Sync.Enabled {
 		if err := r.syncRemoteKymaSpecAndStatus(ctx, kyma); err != nil {
+			metrics.RecordRemoteSyncFailure(kyma)
+			kyma.UpdateCondition(v1beta1.ConditionTypeRemoteSync,
+				v1beta1.ConditionReasonRemoteKymaIsSynced, metav1.ConditionFalse)
 			return r.CtrlErr(ctx, kyma, fmt.Errorf("could not synchronize remote kyma: %w", err))
 		}
+		kyma.UpdateCondition(v1beta1.ConditionTypeRemoteSync,
+			v1beta1.ConditionReasonRemoteKymaIsSynced, metav1.ConditionTrue)
+	} else {
+		kyma.RemoveCondition(v1beta1.ConditionTypeRemoteSync)
 	}
 
 	// state handling
 	return r.stateHandling(ctx, kyma)
 }
 
+// pauseReconciliation only reflects the Pause of the Kyma in its status and requeues the Kyma once the Pause
+// expires. The Manifests of the Kyma are paused by the manifest reconciler.
+func (r *KymaReconciler) pauseReconciliation(
+	ctx context.Context, kyma *v1beta1.Kyma, previousCondition metav1.Condition,
+) (ctrl.Result, error) {
+	requeueAfter := r.RequeueIntervals.Success
+	if expiry := kyma.Spec.Pause.Expiry(); !expiry.IsZero() && time.Until(expiry) < requeueAfter {
+		requeueAfter = time.Until(expiry)
+	}
+
+	pausedCondition := meta.FindStatusCondition(kyma.Status.Conditions, string(v1beta1.ConditionTypePaused))
+	if previousCondition.Status == pausedCondition.Status && previousCondition.Message == pausedCondition.Message {
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+	if err := r.UpdateStatusWithEvent(ctx, kyma, kyma.Status.State, pausedCondition.Message); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
 func (r *KymaReconciler) deleteKyma(ctx context.Context, kyma *v1beta1.Kyma) (ctrl.Result, error) {
 	if err := r.TriggerKymaDeletion(ctx, kyma); err != nil {
 		return r.CtrlErr(ctx, kyma, err)
@@ -181,12 +245,44 @@ func (r *KymaReconciler) syncRemoteKymaSpecAndStatus(
 	return nil
 }
 
+// planModules resolves the Modules of the Kyma without applying them and writes the resulting plan into the status.
+// The remote Kyma is only read to include the Modules declared in the runtime, it is neither created nor updated.
+func (r *KymaReconciler) planModules(ctx context.Context, kyma *v1beta1.Kyma) (ctrl.Result, error) {
+	if kyma.Spec.Sync.Enabled {
+		syncContext := remote.SyncContextFromContext(ctx)
+		remoteKyma, err := syncContext.GetRemotelySyncedKyma(ctx, kyma)
+		if err == nil {
+			syncContext.ReplaceWithVirtualKyma(kyma, remoteKyma)
+		} else if !k8serrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+			return r.CtrlErr(ctx, kyma, fmt.Errorf("could not fetch remote kyma for dry-run: %w", err))
+		}
+	}
+
+	modules, err := r.GenerateModulesFromTemplate(ctx, kyma)
+	if err != nil {
+		return r.CtrlErr(ctx, kyma, fmt.Errorf("could not plan modules during dry-run: %w", err))
+	}
+
+	plan := modules.Plan(kyma)
+	if equality.Semantic.DeepEqual(kyma.Status.Plan, plan) {
+		return ctrl.Result{RequeueAfter: r.RequeueIntervals.Success}, nil
+	}
+	kyma.Status.Plan = plan
+	if err := r.UpdateStatusWithEvent(ctx, kyma, kyma.Status.State, "dry-run plan generated"); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: r.RequeueIntervals.Success}, nil
+}
+
 func (r *KymaReconciler) syncModuleCatalog(ctx context.Context, kyma *v1beta1.Kyma) error {
 	if !kyma.Spec.Sync.Enabled || !kyma.Spec.Sync.ModuleCatalog {
+		kyma.RemoveCondition(v1beta1.ConditionTypeModuleCatalog)
 		return nil
 	}
 
-	kyma.UpdateCondition(v1beta1.ConditionReasonModuleCatalogIsReady, metav1.ConditionFalse)
+	kyma.UpdateCondition(v1beta1.ConditionTypeModuleCatalog,
+		v1beta1.ConditionReasonModuleCatalogIsReady, metav1.ConditionFalse)
 
 	moduleTemplateList := &v1beta1.ModuleTemplateList{}
 	if err := r.List(ctx, moduleTemplateList, &client.ListOptions{}); err != nil {
@@ -197,7 +293,8 @@ func (r *KymaReconciler) syncModuleCatalog(ctx context.Context, kyma *v1beta1.Ky
 		return fmt.Errorf("could not synchronize remote module catalog: %w", err)
 	}
 
-	kyma.UpdateCondition(v1beta1.ConditionReasonModuleCatalogIsReady, metav1.ConditionTrue)
+	kyma.UpdateCondition(v1beta1.ConditionTypeModuleCatalog,
+		v1beta1.ConditionReasonModuleCatalogIsReady, metav1.ConditionTrue)
 
 	return nil
 }
@@ -234,7 +331,13 @@ func (r *KymaReconciler) HandleProcessingState(ctx context.Context, kyma *v1beta
 	conditionStatus := metav1.ConditionTrue
 	if err := r.syncModules(ctx, kyma); err != nil {
 		conditionStatus = metav1.ConditionFalse
-		kyma.UpdateCondition(conditionReason, conditionStatus)
+		switch {
+		case errors.Is(err, channel.ErrNoTemplateMatchesVersion):
+			conditionReason = v1beta1.ConditionReasonModuleVersionNotFound
+		case errors.Is(err, channel.ErrTemplateRemoved):
+			conditionReason = v1beta1.ConditionReasonModuleRemoved
+		}
+		kyma.UpdateCondition(v1beta1.ConditionTypeModules, conditionReason, conditionStatus)
 		return r.UpdateStatusWithEventFromErr(ctx, kyma, v1beta1.StateError, err)
 	}
 	for i := range kyma.Status.Modules {
@@ -244,22 +347,23 @@ func (r *KymaReconciler) HandleProcessingState(ctx context.Context, kyma *v1beta
 			break
 		}
 	}
-	kyma.UpdateCondition(conditionReason, conditionStatus)
-
-	if kyma.Spec.Sync.Enabled && r.SKRWebhookManager != nil {
-		if err := r.SKRWebhookManager.Install(ctx, kyma); err != nil {
-			kyma.UpdateCondition(v1beta1.ConditionReasonSKRWebhookIsReady, metav1.ConditionFalse)
-			// TODO Move installation to own go-routine to not block installation
-			// + consider introducing own condition for CertificateReady Status
-			// https://github.com/kyma-project/lifecycle-manager/issues/376
-			if !errors.Is(err, &watcher.CertificateNotReadyError{}) {
-				return r.UpdateStatusWithEventFromErr(ctx, kyma, v1beta1.StateError,
-					fmt.Errorf("error while installing Watcher Webhook Chart: %w", err))
-			}
+	kyma.UpdateCondition(v1beta1.ConditionTypeModules, conditionReason, conditionStatus)
+
+	if !kyma.Spec.Sync.Enabled || r.SKRWebhookManager == nil {
+		kyma.RemoveCondition(v1beta1.ConditionTypeSKRWebhook)
+	} else if err := r.SKRWebhookManager.Install(ctx, kyma); err != nil {
+		kyma.UpdateCondition(v1beta1.ConditionTypeSKRWebhook,
+			v1beta1.ConditionReasonSKRWebhookIsReady, metav1.ConditionFalse)
+		// TODO Move installation to own go-routine to not block installation
+		// + consider introducing own condition for CertificateReady Status
+		// https://github.com/kyma-project/lifecycle-manager/issues/376
+		if !errors.Is(err, &watcher.CertificateNotReadyError{}) {
+			return r.UpdateStatusWithEventFromErr(ctx, kyma, v1beta1.StateError,
+				fmt.Errorf("error while installing Watcher Webhook Chart: %w", err))
 		}
 	}
 
-	// set ready condition if applicable
+	// the ready condition is derived from all subsystem conditions on status update
 	state := kyma.DetermineState()
 
 	if state == v1beta1.StateReady {
@@ -288,25 +392,105 @@ func (r *KymaReconciler) syncModules(ctx context.Context, kyma *v1beta1.Kyma) er
 		return fmt.Errorf("error while fetching modules during processing: %w", err)
 	}
 
+	r.protectFromDowngrades(kyma, modules)
+	r.warnAboutDeprecations(kyma, modules)
+
+	inMaintenanceWindow, err := kyma.IsInMaintenanceWindow(time.Now())
+	if err != nil {
+		return fmt.Errorf("could not determine maintenance window: %w", err)
+	}
+	if !inMaintenanceWindow {
+		modules.DeferVersionChanges(kyma)
+	}
+
 	runner := sync.New(r)
 
 	if err := runner.Sync(ctx, kyma, modules); err != nil {
 		return fmt.Errorf("sync failed: %w", err)
 	}
 
-	runner.SyncModuleStatus(ctx, kyma, modules)
 	// If module get removed from kyma, the module deletion happens here.
-
+	// It is triggered before the status sync, so that the status reflects the deletion right away.
 	if err := r.DeleteNoLongerExistingModules(ctx, kyma); err != nil {
 		return fmt.Errorf("error while syncing conditions during deleting non exists modules: %w", err)
 	}
+
+	runner.SyncModuleStatus(ctx, kyma, modules)
 	return nil
 }
 
+// protectFromDowngrades blocks all Modules that would be downgraded unless the DowngradePolicy allows it
+// and reflects the blocked Modules in the DowngradeProtection condition. The downgrade of a Module
+// is only reported once, when it is blocked for the first time.
+func (r *KymaReconciler) protectFromDowngrades(kyma *v1beta1.Kyma, modules common.Modules) {
+	if r.DowngradePolicy == common.DowngradePolicyAllow {
+		kyma.RemoveCondition(v1beta1.ConditionTypeDowngradeProtection)
+		return
+	}
+
+	blocked := modules.BlockDowngrades(kyma)
+	if len(blocked) == 0 {
+		kyma.UpdateCondition(v1beta1.ConditionTypeDowngradeProtection,
+			v1beta1.ConditionReasonModuleDowngrade, metav1.ConditionTrue)
+		return
+	}
+
+	kyma.UpdateCondition(v1beta1.ConditionTypeDowngradeProtection,
+		v1beta1.ConditionReasonModuleDowngrade, metav1.ConditionFalse)
+	for _, module := range blocked {
+		if moduleStatus := kyma.GetModuleStatus(module.ModuleName); moduleStatus != nil &&
+			moduleStatus.BlockedDowngradeVersion == module.Version {
+			continue
+		}
+		r.Event(kyma, "Warning", string(ModuleDowngradeBlocked), fmt.Sprintf(
+			"downgrade of module %s to version %s was blocked, add it to the %s annotation to allow it",
+			module.ModuleName, module.Version, v1beta1.AllowDowngradeAnnotation))
+	}
+}
+
+// warnAboutDeprecations reflects all Modules resolved from deprecated ModuleTemplates
+// in the ModuleDeprecation condition and emits an event whenever a Module becomes deprecated.
+func (r *KymaReconciler) warnAboutDeprecations(kyma *v1beta1.Kyma, modules common.Modules) {
+	var previousMessage string
+	if condition := meta.FindStatusCondition(kyma.Status.Conditions,
+		string(v1beta1.ConditionTypeModuleDeprecation)); condition != nil &&
+		condition.Status == metav1.ConditionTrue {
+		previousMessage = condition.Message
+	}
+
+	var notices []string
+	for _, module := range modules {
+		if deprecation := module.Template.Spec.Deprecation; deprecation != nil {
+			notices = append(notices, deprecation.Notice(module.ModuleName, module.Version, module.Template.Spec.Channel))
+		}
+	}
+	sort.Strings(notices)
+
+	kyma.UpdateDeprecationCondition(notices)
+	for _, notice := range notices {
+		if !strings.Contains(previousMessage, notice) {
+			r.Event(kyma, "Warning", string(ModuleDeprecated), notice)
+		}
+	}
+}
+
 func (r *KymaReconciler) HandleDeletingState(ctx context.Context, kyma *v1beta1.Kyma) (bool, error) {
 	logger := ctrlLog.FromContext(ctx).V(log.InfoLevel)
 
-	if kyma.Spec.Sync.Enabled && r.SKRWebhookManager != nil {
+	// modules are deleted explicitly instead of relying on garbage collection,
+	// so that modules are removed before the modules they depend on
+	if modulesDeleting, err := r.deleteModulesInDependencyOrder(
+		ctx, kyma.Status.Modules, kyma.Spec.DeletionPolicy,
+	); err != nil {
+		err := fmt.Errorf("error while trying to delete modules: %w", err)
+		r.Event(kyma, "Warning", string(DeletionError), err.Error())
+		return false, err
+	} else if modulesDeleting {
+		return true, nil
+	}
+
+	// orphaned kymas leave the remote cluster untouched apart from the finalizer on the remote kyma
+	if kyma.Spec.Sync.Enabled && r.SKRWebhookManager != nil && !kyma.OrphansModules() {
 		if err := r.SKRWebhookManager.Remove(ctx, kyma); err != nil {
 			// here we expect that an error is normal and means we have to try again if it didn't work
 			r.Event(kyma, "Normal", "WebhookChartRemoval", err.Error())
@@ -315,10 +499,12 @@ func (r *KymaReconciler) HandleDeletingState(ctx context.Context, kyma *v1beta1.
 	}
 
 	if kyma.Spec.Sync.Enabled {
-		if err := remote.NewRemoteCatalogFromKyma(kyma).Delete(ctx); err != nil {
-			err := fmt.Errorf("could not delete remote module catalog: %w", err)
-			r.Event(kyma, "Warning", string(DeletionError), err.Error())
-			return false, err
+		if !kyma.OrphansModules() {
+			if err := remote.NewRemoteCatalogFromKyma(kyma).Delete(ctx); err != nil {
+				err := fmt.Errorf("could not delete remote module catalog: %w", err)
+				r.Event(kyma, "Warning", string(DeletionError), err.Error())
+				return false, err
+			}
 		}
 
 		r.RemoteClientCache.Del(client.ObjectKeyFromObject(kyma))
@@ -345,7 +531,7 @@ func (r *KymaReconciler) HandleDeletingState(ctx context.Context, kyma *v1beta1.
 func (r *KymaReconciler) TriggerKymaDeletion(ctx context.Context, kyma *v1beta1.Kyma) error {
 	logger := ctrlLog.FromContext(ctx).V(log.InfoLevel)
 
-	if kyma.Spec.Sync.Enabled {
+	if kyma.Spec.Sync.Enabled && !kyma.OrphansModules() {
 		if err := remote.DeleteRemotelySyncedKyma(ctx, kyma); client.IgnoreNotFound(err) != nil {
 			logger.Error(err, "Failed to be deleted remotely!")
 			return fmt.Errorf("error occurred while trying to delete remotely synced kyma: %w", err)
@@ -386,46 +572,141 @@ func (r *KymaReconciler) UpdateStatusWithEventFromErr(
 
 func (r *KymaReconciler) GenerateModulesFromTemplate(ctx context.Context, kyma *v1beta1.Kyma) (common.Modules, error) {
 	// fetch templates
-	templates, err := channel.GetTemplates(ctx, r, kyma)
+	lookupStart := time.Now()
+	templates, err := channel.GetTemplates(ctx, r, kyma, r.TemplateResolutionStrategy)
+	metrics.ObserveTemplateLookup(lookupStart)
 	if err != nil {
 		return nil, fmt.Errorf("templates could not be fetched: %w", err)
 	}
 
-	verification, err := r.VerificationSettings.NewVerification(ctx, kyma.GetNamespace())
+	policy, err := r.VerificationSettings.PolicyFor(ctx, kyma)
+	if err != nil {
+		return nil, err
+	}
+	verification, warnings, err := r.VerificationSettings.NewPolicyVerification(ctx, kyma.GetNamespace(), policy)
 	if err != nil {
 		return nil, err
 	}
 
+	validation := &parse.CRDSchemaValidation{ControlPlane: r}
+	if kyma.Spec.Sync.Enabled {
+		validation.Runtime = remote.SyncContextFromContext(ctx).RuntimeClient
+	}
+
 	// these are the actual modules
-	modules, err := parse.GenerateModulesFromTemplates(kyma, templates, verification)
+	modules, err := parse.GenerateModulesFromTemplates(ctx, kyma, templates, verification, validation)
 	if err != nil {
 		return nil, fmt.Errorf("cannot generate modules: %w", err)
 	}
+	// a plan does not install the modules, so there is nothing to warn about yet
+	if !kyma.IsDryRun() {
+		r.warnAboutUnverifiedModules(kyma, warnings.List())
+	}
 
 	return modules, nil
 }
 
+// warnAboutUnverifiedModules reflects the Modules installed under the Warn policy although their signatures
+// could not be verified in the ModuleVerification condition and emits an event for every new failure.
+func (r *KymaReconciler) warnAboutUnverifiedModules(kyma *v1beta1.Kyma, warnings []string) {
+	var previousMessage string
+	if condition := meta.FindStatusCondition(kyma.Status.Conditions,
+		string(v1beta1.ConditionTypeModuleVerification)); condition != nil &&
+		condition.Status == metav1.ConditionFalse {
+		previousMessage = condition.Message
+	}
+
+	kyma.UpdateVerificationCondition(warnings)
+	for _, warning := range warnings {
+		if !strings.Contains(previousMessage, warning) {
+			r.Event(kyma, "Warning", string(ModuleUnverified), warning)
+		}
+	}
+}
+
 func (r *KymaReconciler) DeleteNoLongerExistingModules(ctx context.Context, kyma *v1beta1.Kyma) error {
 	moduleStatus := kyma.GetNoLongerExistingModuleStatus()
-	var err error
 	if len(moduleStatus) == 0 {
 		return nil
 	}
+	if _, err := r.deleteModulesInDependencyOrder(ctx, moduleStatus, v1beta1.DeletionPolicyCascade); err != nil {
+		return fmt.Errorf("error deleting module %w", err)
+	}
+	return nil
+}
+
+// deleteModulesInDependencyOrder deletes the Manifests of the given Modules in the reverse order of their
+// dependencies: a Manifest is only deleted once no Manifest of a Module depending on it exists anymore.
+// It returns true as long as there are Manifests left that were not yet removed from the cluster.
+// With the Orphan DeletionPolicy, the resources of the Manifests are kept in the remote cluster.
+func (r *KymaReconciler) deleteModulesInDependencyOrder(
+	ctx context.Context, moduleStatus []v1beta1.ModuleStatus, policy v1beta1.DeletionPolicy,
+) (bool, error) {
+	remaining := make([]v1beta1.ModuleStatus, 0, len(moduleStatus))
+	dependencies := make(map[string][]string, len(moduleStatus))
 	for i := range moduleStatus {
-		moduleStatus := moduleStatus[i]
-		err = r.deleteModule(ctx, moduleStatus)
+		manifest := manifestFromModuleStatus(moduleStatus[i])
+		if err := r.Get(ctx, client.ObjectKeyFromObject(&manifest), &manifest); err != nil {
+			if client.IgnoreNotFound(err) != nil {
+				return false, err
+			}
+			continue
+		}
+		template := &v1beta1.ModuleTemplate{}
+		templateKey := client.ObjectKey{
+			Namespace: moduleStatus[i].Template.GetNamespace(), Name: moduleStatus[i].Template.GetName(),
+		}
+		if err := r.Get(ctx, templateKey, template); client.IgnoreNotFound(err) != nil {
+			return false, err
+		}
+		remaining = append(remaining, moduleStatus[i])
+		dependencies[moduleStatus[i].Name] = template.Spec.Dependencies
 	}
 
-	if client.IgnoreNotFound(err) != nil {
-		return fmt.Errorf("error deleting module %w", err)
+	for i := range remaining {
+		if hasRemainingDependents(remaining[i], remaining, dependencies) {
+			continue
+		}
+		if err := r.deleteModule(ctx, remaining[i], policy); client.IgnoreNotFound(err) != nil {
+			return false, err
+		}
 	}
-	return nil
+	return len(remaining) > 0, nil
 }
 
-func (r *KymaReconciler) deleteModule(ctx context.Context, moduleStatus v1beta1.ModuleStatus) error {
+func hasRemainingDependents(
+	dependency v1beta1.ModuleStatus, remaining []v1beta1.ModuleStatus, dependencies map[string][]string,
+) bool {
+	for _, dependent := range remaining {
+		for _, reference := range dependencies[dependent.Name] {
+			if reference == dependency.Name || reference == dependency.FQDN {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (r *KymaReconciler) deleteModule(
+	ctx context.Context, moduleStatus v1beta1.ModuleStatus, policy v1beta1.DeletionPolicy,
+) error {
+	manifest := manifestFromModuleStatus(moduleStatus)
+	if policy == v1beta1.DeletionPolicyOrphan {
+		// the annotation has to be present before the deletion is triggered,
+		// so that the manifest reconciler never starts to clean up the resources
+		patch := client.RawPatch(types.MergePatchType, []byte(fmt.Sprintf(
+			`{"metadata":{"annotations":{%q:"true"}}}`, v1beta1.OrphanResourcesAnnotation)))
+		if err := r.Patch(ctx, &manifest, patch); err != nil {
+			return err
+		}
+	}
+	return r.Delete(ctx, &manifest, &client.DeleteOptions{})
+}
+
+func manifestFromModuleStatus(moduleStatus v1beta1.ModuleStatus) metav1.PartialObjectMetadata {
 	manifest := metav1.PartialObjectMetadata{}
 	manifest.SetGroupVersionKind(moduleStatus.Manifest.GroupVersionKind())
 	manifest.SetNamespace(moduleStatus.Manifest.GetNamespace())
 	manifest.SetName(moduleStatus.Manifest.GetName())
-	return r.Delete(ctx, &manifest, &client.DeleteOptions{})
+	return manifest
 }
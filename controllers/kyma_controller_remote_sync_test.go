@@ -101,7 +101,7 @@ var _ = Describe("Kyma sync into Remote Cluster", Ordered, func() {
 		Eventually(func() {
 			remoteKyma, err = GetKyma(ctx, runtimeClient, kyma.GetName(), kyma.Spec.Sync.Namespace)
 			Expect(err).ShouldNot(HaveOccurred())
-			Expect(remoteKyma.ContainsCondition(v1beta1.ConditionTypeReady,
+			Expect(remoteKyma.ContainsCondition(v1beta1.ConditionTypeModuleCatalog,
 				v1beta1.ConditionReasonModuleCatalogIsReady)).To(BeTrue())
 		}, Timeout, Interval)
 
@@ -115,3 +115,45 @@ var _ = Describe("Kyma sync into Remote Cluster", Ordered, func() {
 			runtimeClient, kyma, 0, true), 20*time.Second, Interval).Should(Succeed())
 	})
 })
+
+var _ = Describe("Kyma with Orphan deletion policy in remote sync mode", Ordered, func() {
+	kyma := NewTestKyma("kyma-orphan-remote")
+	kyma.Spec.DeletionPolicy = v1beta1.DeletionPolicyOrphan
+	kyma.Spec.Sync = v1beta1.Sync{
+		Enabled:      true,
+		Strategy:     v1beta1.SyncStrategyLocalClient,
+		Namespace:    metav1.NamespaceDefault,
+		NoModuleCopy: true,
+	}
+	kyma.Spec.Modules = append(kyma.Spec.Modules, v1beta1.Module{
+		ControllerName: "manifest",
+		Name:           "skr-module-orphan",
+		Channel:        v1beta1.DefaultChannel,
+	})
+
+	BeforeAll(func() {
+		Expect(controlPlaneClient.Create(ctx, kyma)).Should(Succeed())
+		DeployModuleTemplates(ctx, controlPlaneClient, kyma)
+	})
+
+	AfterAll(func() {
+		DeleteModuleTemplates(ctx, controlPlaneClient, kyma)
+	})
+
+	It("should keep the remote Kyma and Module Catalog after the Kyma is deleted", func() {
+		By("Remote Kyma and Module Catalog created")
+		Eventually(KymaExists(runtimeClient, kyma.GetName(), kyma.Spec.Sync.Namespace), 30*time.Second, Interval).
+			Should(Succeed())
+		Eventually(ModuleTemplatesExist(runtimeClient, kyma, true), 30*time.Second, Interval).Should(Succeed())
+
+		By("deleting the Kyma in the control plane")
+		Expect(controlPlaneClient.Delete(ctx, kyma)).Should(Succeed())
+		Eventually(KymaExists(controlPlaneClient, kyma.GetName(), kyma.GetNamespace()), Timeout, Interval).
+			ShouldNot(Succeed())
+
+		By("Remote Kyma and Module Catalog kept")
+		Consistently(KymaExists(runtimeClient, kyma.GetName(), kyma.Spec.Sync.Namespace), 5*time.Second, Interval).
+			Should(Succeed())
+		Expect(ModuleTemplatesExist(runtimeClient, kyma, true)()).Should(Succeed())
+	})
+})
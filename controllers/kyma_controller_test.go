@@ -62,15 +62,19 @@ var _ = Describe("Kyma with empty ModuleTemplate", Ordered, func() {
 		Expect(err).ShouldNot(HaveOccurred())
 		Expect(
 			kymaInCluster.ContainsCondition(
-				v1beta1.ConditionTypeReady,
+				v1beta1.ConditionTypeModules,
 				v1beta1.ConditionReasonModulesAreReady, metav1.ConditionTrue)).To(BeTrue())
+		Expect(
+			kymaInCluster.ContainsCondition(
+				v1beta1.ConditionTypeReady,
+				v1beta1.ConditionReasonKymaIsReady, metav1.ConditionTrue)).To(BeTrue())
 		By("Module Catalog created")
 		Eventually(ModuleTemplatesExist(controlPlaneClient, kyma, false), 10*time.Second, Interval).Should(Succeed())
 		kymaInCluster, err = GetKyma(ctx, controlPlaneClient, kyma.GetName(), kyma.GetNamespace())
 		Expect(err).ShouldNot(HaveOccurred())
 		Expect(
 			kymaInCluster.ContainsCondition(
-				v1beta1.ConditionTypeReady,
+				v1beta1.ConditionTypeModuleCatalog,
 				v1beta1.ConditionReasonModuleCatalogIsReady,
 			)).To(BeFalse())
 	})
@@ -131,6 +135,41 @@ var _ = Describe("Kyma with multiple module CRs", Ordered, func() {
 	})
 })
 
+var _ = Describe("Kyma with a module removed from the middle of its modules", Ordered, func() {
+	kyma := NewTestKyma("kyma-test-remove-middle")
+	modules := []v1beta1.Module{
+		{ControllerName: "manifest", Name: "first-module", Channel: v1beta1.DefaultChannel},
+		{ControllerName: "manifest", Name: "middle-module", Channel: v1beta1.DefaultChannel},
+		{ControllerName: "manifest", Name: "last-module", Channel: v1beta1.DefaultChannel},
+	}
+	kyma.Spec.Modules = append(kyma.Spec.Modules, modules...)
+	RegisterDefaultLifecycleForKyma(kyma)
+
+	It("only deletes the Manifest of the removed module", func() {
+		By("CR created")
+		for _, activeModule := range kyma.Spec.Modules {
+			Eventually(ModuleExists(ctx, kyma, activeModule), Timeout, Interval).Should(Succeed())
+		}
+
+		By("Remove middle-module from kyma.spec.modules")
+		kyma.Spec.Modules = []v1beta1.Module{modules[0], modules[2]}
+		Expect(controlPlaneClient.Update(ctx, kyma)).To(Succeed())
+
+		By("middle-module deleted")
+		Eventually(ModuleNotExist(ctx, kyma, modules[1]), Timeout, Interval).Should(Succeed())
+
+		By("first-module and last-module still exist")
+		Consistently(ModuleExists(ctx, kyma, modules[0]), 2*time.Second, Interval).Should(Succeed())
+		Consistently(ModuleExists(ctx, kyma, modules[2]), 2*time.Second, Interval).Should(Succeed())
+
+		By("middle-module no longer tracked in the status")
+		Eventually(func() bool {
+			kymaInCluster, err := GetKyma(ctx, controlPlaneClient, kyma.GetName(), kyma.GetNamespace())
+			return err == nil && kymaInCluster.GetModuleStatus(modules[1].Name) == nil
+		}, Timeout, Interval).Should(BeTrue())
+	})
+})
+
 var _ = Describe("Kyma update Manifest CR", Ordered, func() {
 	kyma := NewTestKyma("kyma-test-update")
 
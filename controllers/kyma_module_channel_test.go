@@ -198,6 +198,13 @@ var _ = Describe("Switching of a Channel with higher version leading to an Upgra
 			whenUpdatingEveryModuleChannel(kyma.Name, v1beta1.DefaultChannel),
 			expectEveryModuleStatusToHaveChannel(kyma.Name, FastChannel),
 		),
+		Entry(
+			"When all modules are reverted to regular channel,"+
+				" expect the downgrade to be blocked",
+			noCondition(),
+			expectKymaToHaveCondition(kyma.Name, v1beta1.ConditionTypeDowngradeProtection,
+				v1beta1.ConditionReasonModuleDowngrade, metaV1.ConditionFalse),
+		),
 	)
 
 	It(
@@ -240,3 +247,103 @@ func whenUpdatingEveryModuleChannel(kymaName, channel string) func() error {
 		return UpdateKymaModuleChannels(kymaName, channel)
 	}
 }
+
+var _ = Describe("Pinning a Module Version across Channels", Ordered, func() {
+	kyma := NewTestKyma("version-pinned-kyma")
+
+	kyma.Spec.Modules = append(
+		kyma.Spec.Modules, v1beta1.Module{
+			ControllerName: "manifest",
+			Name:           "version-pin",
+			Version:        HigherVersion,
+		})
+
+	BeforeAll(func() {
+		Expect(CreateModuleTemplateSetsForKyma(kyma.Spec.Modules, LowerVersion, v1beta1.DefaultChannel)).To(Succeed())
+		Expect(CreateModuleTemplateSetsForKyma(kyma.Spec.Modules, HigherVersion, FastChannel)).To(Succeed())
+		Expect(controlPlaneClient.Create(ctx, kyma)).ToNot(HaveOccurred())
+	})
+
+	AfterAll(func() {
+		Expect(controlPlaneClient.Delete(ctx, kyma)).Should(Succeed())
+	})
+
+	AfterAll(CleanupModuleTemplateSetsForKyma(kyma))
+
+	DescribeTable(
+		"Test Version Pinning", func(givenCondition func() error, expectedBehavior func() error) {
+			Eventually(givenCondition, Timeout, Interval).Should(Succeed())
+			Eventually(expectedBehavior, Timeout, Interval).Should(Succeed())
+		},
+		Entry(
+			"When a module is pinned to the higher version in the default channel,"+
+				" expect Modules to resolve the template from the fast channel",
+			noCondition(),
+			expectEveryModuleStatusToHaveVersionAndChannel(kyma.Name, HigherVersion, FastChannel),
+		),
+		Entry(
+			"When a module is pinned to a range only matching the lower version,"+
+				" expect Modules to resolve the template from the regular channel",
+			whenUpdatingEveryModuleVersion(kyma.Name, "<"+HigherVersion),
+			expectEveryModuleStatusToHaveVersionAndChannel(kyma.Name, LowerVersion, v1beta1.DefaultChannel),
+		),
+		Entry(
+			"When a module is pinned to a version that is not available,"+
+				" expect Kyma to report that no module version was found",
+			whenUpdatingEveryModuleVersion(kyma.Name, "9.9.9"),
+			expectKymaToHaveCondition(kyma.Name, v1beta1.ConditionTypeModules,
+				v1beta1.ConditionReasonModuleVersionNotFound, metaV1.ConditionFalse),
+		),
+	)
+})
+
+func whenUpdatingEveryModuleVersion(kymaName, version string) func() error {
+	return func() error {
+		kyma, err := GetKyma(ctx, controlPlaneClient, kymaName, "")
+		if err != nil {
+			return err
+		}
+		for i := range kyma.Spec.Modules {
+			kyma.Spec.Modules[i].Version = version
+		}
+		return controlPlaneClient.Update(ctx, kyma)
+	}
+}
+
+var ErrModuleVersionMismatch = errors.New("mismatch in module status version")
+
+func expectEveryModuleStatusToHaveVersionAndChannel(kymaName, version, channel string) func() error {
+	return func() error {
+		if err := TemplateInfosMatchChannel(kymaName, channel); err != nil {
+			return err
+		}
+		kyma, err := GetKyma(ctx, controlPlaneClient, kymaName, "")
+		if err != nil {
+			return err
+		}
+		for i := range kyma.Status.Modules {
+			if kyma.Status.Modules[i].Version != version {
+				return fmt.Errorf("%w: %s should be %s",
+					ErrModuleVersionMismatch, kyma.Status.Modules[i].Version, version)
+			}
+		}
+		return nil
+	}
+}
+
+var ErrConditionReasonMissing = errors.New("expected condition reason is missing")
+
+func expectKymaToHaveCondition(kymaName string, conditionType v1beta1.KymaConditionType,
+	reason v1beta1.KymaConditionReason, status metaV1.ConditionStatus,
+) func() error {
+	return func() error {
+		kyma, err := GetKyma(ctx, controlPlaneClient, kymaName, "")
+		if err != nil {
+			return err
+		}
+		if !kyma.ContainsCondition(conditionType, reason, status) {
+			return fmt.Errorf("%w: %s %s=%s", ErrConditionReasonMissing, conditionType, reason, status)
+		}
+		return nil
+	}
+}
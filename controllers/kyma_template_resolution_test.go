@@ -0,0 +1,88 @@
+package controllers_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+	. "github.com/kyma-project/lifecycle-manager/pkg/testutils"
+)
+
+var _ = Describe("Resolving one of several ModuleTemplates in a Channel", Ordered, func() {
+	kyma := NewTestKyma("resolution-kyma")
+
+	kyma.Spec.Modules = append(
+		kyma.Spec.Modules, v1beta1.Module{
+			ControllerName:     "manifest",
+			Name:               "resolution",
+			Channel:            v1beta1.DefaultChannel,
+			ResolutionStrategy: v1beta1.TemplateResolutionHighestVersion,
+		})
+
+	BeforeAll(func() {
+		for _, module := range kyma.Spec.Modules {
+			Expect(createModuleTemplateInChannel(module, HigherVersion, "higher")).To(Succeed())
+			Expect(createModuleTemplateInChannel(module, LowerVersion, "lower")).To(Succeed())
+			Expect(createModuleTemplateInChannel(module, "not-a-version", "invalid")).To(Succeed())
+		}
+		Expect(controlPlaneClient.Create(ctx, kyma)).ToNot(HaveOccurred())
+	})
+
+	AfterAll(func() {
+		Expect(controlPlaneClient.Delete(ctx, kyma)).Should(Succeed())
+		for _, module := range kyma.Spec.Modules {
+			for _, suffix := range []string{"higher", "lower", "invalid"} {
+				template, err := ModuleTemplateFactory(module, unstructured.Unstructured{})
+				Expect(err).ShouldNot(HaveOccurred())
+				template.Name = fmt.Sprintf("%s-%s", template.Name, suffix)
+				Expect(controlPlaneClient.Delete(ctx, template)).To(Succeed())
+			}
+		}
+	})
+
+	It("should skip the template with an invalid version and resolve the one with the highest version", func() {
+		Eventually(expectModuleStatusResolution(kyma.Name, "resolution", HigherVersion,
+			string(v1beta1.TemplateResolutionHighestVersion)), Timeout, Interval).Should(Succeed())
+	})
+})
+
+func createModuleTemplateInChannel(module v1beta1.Module, version, suffix string) error {
+	template, err := ModuleTemplateFactory(module, unstructured.Unstructured{})
+	if err != nil {
+		return err
+	}
+	if err := template.Spec.ModifyDescriptor(
+		v1beta1.ModifyDescriptorVersion(func(*semver.Version) string { return version }),
+	); err != nil {
+		return err
+	}
+	template.Spec.Channel = module.Channel
+	template.Name = fmt.Sprintf("%s-%s", template.Name, suffix)
+	return controlPlaneClient.Create(ctx, template)
+}
+
+var ErrModuleResolutionMismatch = errors.New("module was not resolved as expected")
+
+func expectModuleStatusResolution(kymaName, moduleName, version, strategy string) func() error {
+	return func() error {
+		kyma, err := GetKyma(ctx, controlPlaneClient, kymaName, "")
+		if err != nil {
+			return err
+		}
+		moduleStatus := kyma.GetModuleStatus(moduleName)
+		if moduleStatus == nil {
+			return fmt.Errorf("%w: no status for module %s", ErrModuleResolutionMismatch, moduleName)
+		}
+		if moduleStatus.Version != version || !strings.Contains(moduleStatus.Resolution, strategy) {
+			return fmt.Errorf("%w: version %s, resolution %q", ErrModuleResolutionMismatch,
+				moduleStatus.Version, moduleStatus.Resolution)
+		}
+		return nil
+	}
+}
@@ -10,6 +10,7 @@ import (
 	internalv1beta1 "github.com/kyma-project/lifecycle-manager/internal/manifest/v1beta1"
 	declarative "github.com/kyma-project/lifecycle-manager/pkg/declarative/v2"
 	"github.com/kyma-project/lifecycle-manager/pkg/labels"
+	"github.com/kyma-project/lifecycle-manager/pkg/metrics"
 	"github.com/kyma-project/lifecycle-manager/pkg/security"
 	listener "github.com/kyma-project/runtime-watcher/listener/pkg/event"
 	"github.com/kyma-project/runtime-watcher/listener/pkg/types"
@@ -61,6 +62,7 @@ func SetupWithManager(
 		Watches(
 			eventChannel, &handler.Funcs{
 				GenericFunc: func(event event.GenericEvent, queue workqueue.RateLimitingInterface) {
+					metrics.RecordSKRListenerEvent(ManifestControllerName)
 					ctrl.Log.WithName("listener").Info(
 						fmt.Sprintf(
 							"event coming from SKR, adding %s to queue",
@@ -70,17 +72,17 @@ func SetupWithManager(
 					queue.Add(ctrl.Request{NamespacedName: client.ObjectKeyFromObject(event.Object)})
 				},
 			},
-		).WithOptions(options).Complete(ManifestReconciler(mgr, codec, insecure, checkInterval))
+		).WithOptions(options).Complete(ManifestReconciler(mgr, codec, insecure, checkInterval, settings.CTFRootPath))
 }
 
 func ManifestReconciler(
 	mgr manager.Manager, codec *v1beta1.Codec, insecure bool,
-	checkInterval time.Duration,
+	checkInterval time.Duration, ctfRoot string,
 ) *declarative.Reconciler {
 	return declarative.NewFromManager(
 		mgr, &v1beta1.Manifest{},
 		declarative.WithSpecResolver(
-			internalv1beta1.NewManifestSpecResolver(codec, insecure),
+			internalv1beta1.NewManifestSpecResolver(codec, insecure, ctfRoot),
 		),
 		declarative.WithCustomReadyCheck(internalv1beta1.NewManifestCustomResourceReadyCheck()),
 		declarative.WithRemoteTargetCluster(
@@ -93,5 +95,8 @@ func ManifestReconciler(
 		declarative.WithPostRun{internalv1beta1.PostRunCreateCR},
 		declarative.WithPreDelete{internalv1beta1.PreDeleteDeleteCR},
 		declarative.WithPeriodicConsistencyCheck(checkInterval),
+		declarative.WithDryRunOn(declarative.DryRunOnAnnotationPresentAndTrue(v1beta1.DryRunAnnotation)),
+		declarative.WithOrphanOn(declarative.OrphanOnAnnotationPresentAndTrue(v1beta1.OrphanResourcesAnnotation)),
+		declarative.WithPauseOn(internalv1beta1.PauseOnManifestOrKyma(mgr.GetClient())),
 	)
 }
@@ -0,0 +1,124 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlLog "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+	"github.com/kyma-project/lifecycle-manager/pkg/log"
+)
+
+// ModuleRolloutReconciler releases the waves of a ModuleRollout one after another.
+type ModuleRolloutReconciler struct {
+	client.Client
+	record.EventRecorder
+	RequeueIntervals
+}
+
+//+kubebuilder:rbac:groups=operator.kyma-project.io,resources=modulerollouts,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=operator.kyma-project.io,resources=modulerollouts/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=operator.kyma-project.io,resources=kymas,verbs=get;list;watch
+
+func (r *ModuleRolloutReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ctrlLog.FromContext(ctx).WithName(req.NamespacedName.String())
+
+	rollout := &v1beta1.ModuleRollout{}
+	if err := r.Get(ctx, req.NamespacedName, rollout); err != nil {
+		logger.V(log.DebugLevel).Info("Failed to get reconciliation object")
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	status := rollout.Status.DeepCopy()
+
+	ready, pending, err := r.isCurrentWaveReady(ctx, rollout)
+	if err != nil {
+		r.Event(rollout, "Warning", "RolloutError", err.Error())
+		rollout.Status.State = v1beta1.StateError
+		rollout.Status.LastOperation = newLastOperation(err.Error())
+		return ctrl.Result{}, r.updateStatus(ctx, rollout, status)
+	}
+
+	switch {
+	case !ready:
+		rollout.Status.State = v1beta1.StateProcessing
+		rollout.Status.LastOperation = newLastOperation(fmt.Sprintf(
+			"waiting for %d kymas of wave %d to become ready", pending, rollout.Status.CurrentWave))
+	case rollout.IsLastWave(rollout.Status.CurrentWave):
+		rollout.Status.State = v1beta1.StateReady
+		rollout.Status.LastOperation = newLastOperation("all waves are released and ready")
+	default:
+		rollout.Status.CurrentWave++
+		rollout.Status.State = v1beta1.StateProcessing
+		rollout.Status.LastOperation = newLastOperation(fmt.Sprintf("released wave %d", rollout.Status.CurrentWave))
+		r.Event(rollout, "Normal", "WaveReleased", rollout.Status.LastOperation.Operation)
+	}
+
+	if err := r.updateStatus(ctx, rollout, status); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: r.RequeueIntervals.Success}, nil
+}
+
+// isCurrentWaveReady determines if all Kymas of the released waves run the target version of the Module
+// and report it as Ready. It also returns the amount of Kymas that are not yet ready.
+func (r *ModuleRolloutReconciler) isCurrentWaveReady(
+	ctx context.Context, rollout *v1beta1.ModuleRollout,
+) (bool, int, error) {
+	kymas := &v1beta1.KymaList{}
+	if err := r.List(ctx, kymas, client.InNamespace(rollout.GetNamespace())); err != nil {
+		return false, 0, fmt.Errorf("could not list kymas for rollout: %w", err)
+	}
+
+	pending := 0
+	for i := range kymas.Items {
+		kyma := &kymas.Items[i]
+		moduleStatus := rolloutModuleStatus(kyma, rollout)
+		if moduleStatus == nil {
+			continue
+		}
+		released, err := rollout.Releases(kyma)
+		if err != nil {
+			return false, 0, err
+		}
+		if released && (moduleStatus.Version != rollout.Spec.Version || moduleStatus.State != v1beta1.StateReady) {
+			pending++
+		}
+	}
+	return pending == 0, pending, nil
+}
+
+// rolloutModuleStatus returns the status of the Module staged by the rollout if the Kyma runs it
+// in the staged channel.
+func rolloutModuleStatus(kyma *v1beta1.Kyma, rollout *v1beta1.ModuleRollout) *v1beta1.ModuleStatus {
+	for i := range kyma.Status.Modules {
+		moduleStatus := &kyma.Status.Modules[i]
+		if rollout.Targets(moduleStatus.Name, moduleStatus.Channel) {
+			return moduleStatus
+		}
+	}
+	return nil
+}
+
+func (r *ModuleRolloutReconciler) updateStatus(
+	ctx context.Context, rollout *v1beta1.ModuleRollout, previous *v1beta1.ModuleRolloutStatus,
+) error {
+	if previous.CurrentWave == rollout.Status.CurrentWave &&
+		previous.State == rollout.Status.State &&
+		previous.LastOperation.Operation == rollout.Status.LastOperation.Operation {
+		return nil
+	}
+	if err := r.Status().Update(ctx, rollout); err != nil {
+		return fmt.Errorf("could not update rollout status: %w", err)
+	}
+	return nil
+}
+
+func newLastOperation(operation string) v1beta1.LastOperation {
+	return v1beta1.LastOperation{Operation: operation, LastUpdateTime: metav1.NewTime(time.Now())}
+}
@@ -0,0 +1,139 @@
+package controllers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+	"github.com/kyma-project/lifecycle-manager/controllers"
+)
+
+const (
+	rolloutModule  = "sample"
+	rolloutVersion = "1.1.0"
+)
+
+func TestModuleRolloutReconciler_Progression(t *testing.T) {
+	t.Parallel()
+	first := rolloutKyma("first", "1.0.0")
+	first.Labels = map[string]string{"wave": "first"}
+	second := rolloutKyma("second", "1.0.0")
+	reconciler, rollout := newRolloutReconciler(t, first, second)
+
+	reconcileRollout(t, reconciler, rollout)
+	assert.Equal(t, 0, rollout.Status.CurrentWave)
+	assert.Equal(t, v1beta1.StateProcessing, rollout.Status.State)
+
+	updateRolloutKyma(t, reconciler, first, rolloutVersion, v1beta1.StateReady)
+	reconcileRollout(t, reconciler, rollout)
+	assert.Equal(t, 1, rollout.Status.CurrentWave)
+	assert.Equal(t, v1beta1.StateProcessing, rollout.Status.State)
+	assert.Equal(t, "released wave 1", rollout.Status.LastOperation.Operation)
+
+	reconcileRollout(t, reconciler, rollout)
+	assert.Equal(t, 1, rollout.Status.CurrentWave)
+	assert.Equal(t, "waiting for 1 kymas of wave 1 to become ready", rollout.Status.LastOperation.Operation)
+
+	updateRolloutKyma(t, reconciler, second, rolloutVersion, v1beta1.StateReady)
+	reconcileRollout(t, reconciler, rollout)
+	assert.Equal(t, 1, rollout.Status.CurrentWave)
+	assert.Equal(t, v1beta1.StateReady, rollout.Status.State)
+}
+
+func TestModuleRolloutReconciler_WaveBlockedUntilPreviousWaveReady(t *testing.T) {
+	t.Parallel()
+	first := rolloutKyma("first", rolloutVersion)
+	first.Labels = map[string]string{"wave": "first"}
+	first.Status.Modules[0].State = v1beta1.StateError
+	second := rolloutKyma("second", "1.0.0")
+	reconciler, rollout := newRolloutReconciler(t, first, second)
+
+	for i := 0; i < 3; i++ {
+		reconcileRollout(t, reconciler, rollout)
+		assert.Equal(t, 0, rollout.Status.CurrentWave)
+		assert.Equal(t, v1beta1.StateProcessing, rollout.Status.State)
+		assert.Equal(t, "waiting for 1 kymas of wave 0 to become ready", rollout.Status.LastOperation.Operation)
+	}
+	released, err := rollout.Releases(first)
+	require.NoError(t, err)
+	assert.True(t, released)
+	released, err = rollout.Releases(second)
+	require.NoError(t, err)
+	assert.False(t, released)
+
+	updateRolloutKyma(t, reconciler, first, rolloutVersion, v1beta1.StateReady)
+	reconcileRollout(t, reconciler, rollout)
+	assert.Equal(t, 1, rollout.Status.CurrentWave)
+	released, err = rollout.Releases(second)
+	require.NoError(t, err)
+	assert.True(t, released)
+}
+
+func newRolloutReconciler(
+	t *testing.T, kymas ...*v1beta1.Kyma,
+) (*controllers.ModuleRolloutReconciler, *v1beta1.ModuleRollout) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1beta1.AddToScheme(scheme))
+	everyKyma := int32(100)
+	rollout := &v1beta1.ModuleRollout{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample-rollout", Namespace: metav1.NamespaceDefault},
+		Spec: v1beta1.ModuleRolloutSpec{
+			Module:  rolloutModule,
+			Channel: v1beta1.DefaultChannel,
+			Version: rolloutVersion,
+			Waves: []v1beta1.RolloutWave{
+				{Name: "first", Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"wave": "first"}}},
+				{Name: "all", Percentage: &everyKyma},
+			},
+		},
+	}
+	objects := []client.Object{rollout}
+	for _, kyma := range kymas {
+		objects = append(objects, kyma)
+	}
+	return &controllers.ModuleRolloutReconciler{
+		Client:        fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(),
+		EventRecorder: record.NewFakeRecorder(len(rollout.Spec.Waves)),
+	}, rollout
+}
+
+func rolloutKyma(name, version string) *v1beta1.Kyma {
+	kyma := &v1beta1.Kyma{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault}}
+	kyma.Status.Modules = []v1beta1.ModuleStatus{{
+		Name:    rolloutModule,
+		Channel: v1beta1.DefaultChannel,
+		Version: version,
+		State:   v1beta1.StateReady,
+	}}
+	return kyma
+}
+
+func updateRolloutKyma(
+	t *testing.T, reconciler *controllers.ModuleRolloutReconciler, kyma *v1beta1.Kyma,
+	version string, state v1beta1.State,
+) {
+	t.Helper()
+	require.NoError(t, reconciler.Get(context.TODO(), client.ObjectKeyFromObject(kyma), kyma))
+	kyma.Status.Modules[0].Version = version
+	kyma.Status.Modules[0].State = state
+	require.NoError(t, reconciler.Status().Update(context.TODO(), kyma))
+}
+
+func reconcileRollout(
+	t *testing.T, reconciler *controllers.ModuleRolloutReconciler, rollout *v1beta1.ModuleRollout,
+) {
+	t.Helper()
+	_, err := reconciler.Reconcile(context.TODO(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(rollout)})
+	require.NoError(t, err)
+	require.NoError(t, reconciler.Get(context.TODO(), client.ObjectKeyFromObject(rollout), rollout))
+}
@@ -0,0 +1,207 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	ocm "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlLog "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+	"github.com/kyma-project/lifecycle-manager/pkg/img"
+	"github.com/kyma-project/lifecycle-manager/pkg/index"
+	"github.com/kyma-project/lifecycle-manager/pkg/log"
+	"github.com/kyma-project/lifecycle-manager/pkg/signature"
+)
+
+// ModuleTemplateReconciler maintains the status of ModuleTemplates, so that module owners can see
+// which Kymas use a template and whether its descriptor can be installed before they publish an update.
+type ModuleTemplateReconciler struct {
+	client.Client
+	record.EventRecorder
+	RequeueIntervals
+	signature.VerificationSettings
+}
+
+//+kubebuilder:rbac:groups=operator.kyma-project.io,resources=moduletemplates,verbs=get;list;watch
+//+kubebuilder:rbac:groups=operator.kyma-project.io,resources=moduletemplates/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=operator.kyma-project.io,resources=kymas,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+func (r *ModuleTemplateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ctrlLog.FromContext(ctx).WithName(req.NamespacedName.String())
+
+	template := &v1beta1.ModuleTemplate{}
+	if err := r.Get(ctx, req.NamespacedName, template); err != nil {
+		logger.V(log.DebugLevel).Info("Failed to get reconciliation object")
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	status := template.Status.DeepCopy()
+
+	if err := r.updateUsage(ctx, template); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	descriptor, err := template.Spec.GetUnsafeDescriptor()
+	if err != nil {
+		r.warnOnNewGeneration(template, "DescriptorError", err)
+		template.Status.Descriptor = nil
+		template.Status.ObservedGeneration = template.GetGeneration()
+		return ctrl.Result{}, r.updateStatus(ctx, template, status)
+	}
+
+	template.Status.Descriptor = &v1beta1.TemplateDescriptor{
+		Name:          descriptor.GetName(),
+		Version:       descriptor.GetVersion(),
+		SchemaVersion: descriptor.Metadata.Version,
+	}
+	r.updateLayers(template, descriptor)
+	r.updateVerification(ctx, template, descriptor)
+	if err := r.updateNewerTemplate(ctx, template, descriptor); err != nil {
+		return ctrl.Result{}, err
+	}
+	template.Status.ObservedGeneration = template.GetGeneration()
+
+	if err := r.updateStatus(ctx, template, status); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: r.RequeueIntervals.Success}, nil
+}
+
+// updateUsage counts the Kymas that resolved the template for one of their Modules.
+func (r *ModuleTemplateReconciler) updateUsage(ctx context.Context, template *v1beta1.ModuleTemplate) error {
+	kymas := &v1beta1.KymaList{}
+	if err := r.List(ctx, kymas,
+		index.KymaTemplateField.WithValue(client.ObjectKeyFromObject(template).String())); err != nil {
+		return fmt.Errorf("could not list kymas for template usage: %w", err)
+	}
+
+	var usedBy []string
+	for _, kyma := range kymas.Items {
+		usedBy = append(usedBy, types.NamespacedName{Namespace: kyma.GetNamespace(), Name: kyma.GetName()}.String())
+	}
+	sort.Strings(usedBy)
+
+	template.Status.UsedByCount = len(usedBy)
+	if len(usedBy) > v1beta1.ModuleTemplateUsedByLimit {
+		usedBy = usedBy[:v1beta1.ModuleTemplateUsedByLimit]
+	}
+	template.Status.UsedBy = usedBy
+	return nil
+}
+
+// warnOnNewGeneration emits a warning about an error in the spec of the template only once per generation,
+// as the error does not change until the template is updated.
+func (r *ModuleTemplateReconciler) warnOnNewGeneration(template *v1beta1.ModuleTemplate, reason string, err error) {
+	if template.Status.ObservedGeneration == template.GetGeneration() {
+		return
+	}
+	r.Event(template, "Warning", reason, err.Error())
+}
+
+func (r *ModuleTemplateReconciler) updateLayers(
+	template *v1beta1.ModuleTemplate, descriptor *ocm.ComponentDescriptor,
+) {
+	layers, err := img.Parse(descriptor)
+	if err != nil {
+		r.warnOnNewGeneration(template, "LayerParsingError", err)
+		template.Status.Layers = nil
+		return
+	}
+
+	template.Status.Layers = make([]v1beta1.TemplateLayer, 0, len(layers))
+	for _, layer := range layers {
+		templateLayer := v1beta1.TemplateLayer{Name: string(layer.LayerName)}
+		switch representation := layer.LayerRepresentation.(type) {
+		case *img.OCI:
+			templateLayer.Type, templateLayer.Ref = representation.Type, representation.String()
+		case *img.Helm:
+			templateLayer.Type, templateLayer.Ref = representation.Type, representation.String()
+		}
+		template.Status.Layers = append(template.Status.Layers, templateLayer)
+	}
+}
+
+// updateVerification verifies the signatures of the descriptor on every reconciliation, so that the
+// result follows the keys in the namespace of the template, e.g. when a key is added, rotated or retired.
+// A failure is only reported in an event when the outcome of the verification changes.
+func (r *ModuleTemplateReconciler) updateVerification(
+	ctx context.Context, template *v1beta1.ModuleTemplate, descriptor *ocm.ComponentDescriptor,
+) {
+	verification := &v1beta1.TemplateVerification{LastVerificationTime: metav1.NewTime(time.Now())}
+	if !r.EnableVerification {
+		verification.Result = v1beta1.TemplateVerificationDisabled
+	} else if key, err := r.VerifyTemplateWithKey(ctx, template, descriptor); err != nil {
+		verification.Result = v1beta1.TemplateVerificationFailed
+		verification.Message = err.Error()
+	} else {
+		verification.Result, verification.Key = v1beta1.TemplateVerificationSucceeded, key
+	}
+
+	previous := template.Status.Verification
+	changed := previous == nil || previous.Result != verification.Result || previous.Message != verification.Message
+	if changed && verification.Result == v1beta1.TemplateVerificationFailed {
+		r.Event(template, "Warning", "VerificationError", verification.Message)
+	}
+	template.Status.Verification = verification
+}
+
+// updateNewerTemplate looks for the template with the highest version of the same Module in the same channel.
+func (r *ModuleTemplateReconciler) updateNewerTemplate(
+	ctx context.Context, template *v1beta1.ModuleTemplate, descriptor *ocm.ComponentDescriptor,
+) error {
+	template.Status.NewerTemplate = ""
+	version, err := semver.NewVersion(descriptor.Version)
+	if err != nil {
+		r.warnOnNewGeneration(template, "DescriptorError", err)
+		return nil
+	}
+
+	templates := &v1beta1.ModuleTemplateList{}
+	if err := r.List(ctx, templates, index.TemplateFQDNField.WithValue(descriptor.GetName())); err != nil {
+		return fmt.Errorf("could not list templates of module %s: %w", descriptor.GetName(), err)
+	}
+
+	highest := version
+	for i := range templates.Items {
+		other := &templates.Items[i]
+		if other.Spec.Channel != template.Spec.Channel ||
+			client.ObjectKeyFromObject(other) == client.ObjectKeyFromObject(template) {
+			continue
+		}
+		otherDescriptor, err := other.Spec.GetUnsafeDescriptor()
+		if err != nil {
+			continue
+		}
+		otherVersion, err := semver.NewVersion(otherDescriptor.Version)
+		if err != nil {
+			continue
+		}
+		if otherVersion.GreaterThan(highest) {
+			highest = otherVersion
+			template.Status.NewerTemplate = other.GetName()
+		}
+	}
+	return nil
+}
+
+func (r *ModuleTemplateReconciler) updateStatus(
+	ctx context.Context, template *v1beta1.ModuleTemplate, previous *v1beta1.ModuleTemplateStatus,
+) error {
+	if equality.Semantic.DeepEqual(previous, &template.Status) {
+		return nil
+	}
+	if err := r.Status().Update(ctx, template); err != nil {
+		return fmt.Errorf("could not update template status: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package controllers_test
+
+import (
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+	. "github.com/kyma-project/lifecycle-manager/pkg/testutils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func templateStatus(name string) func() (*v1beta1.ModuleTemplateStatus, error) {
+	return func() (*v1beta1.ModuleTemplateStatus, error) {
+		template, err := GetModuleTemplate(name)
+		if err != nil {
+			return nil, err
+		}
+		return &template.Status, nil
+	}
+}
+
+var _ = Describe("ModuleTemplate status", Ordered, func() {
+	kyma := NewTestKyma("template-status-kyma")
+	module := v1beta1.Module{
+		ControllerName: "manifest",
+		Name:           NewUniqModuleName(),
+		Channel:        v1beta1.DefaultChannel,
+	}
+	kyma.Spec.Modules = append(kyma.Spec.Modules, module)
+	RegisterDefaultLifecycleForKyma(kyma)
+
+	It("should list the Kyma that resolved the template", func() {
+		Eventually(templateStatus(module.Name), Timeout, Interval).Should(And(
+			HaveField("UsedByCount", 1),
+			HaveField("UsedBy", ConsistOf(client.ObjectKeyFromObject(kyma).String())),
+		))
+	})
+
+	It("should report the parsed layers and the verification result", func() {
+		Eventually(templateStatus(module.Name), Timeout, Interval).Should(And(
+			HaveField("Layers", Not(BeEmpty())),
+			HaveField("Verification.Result", v1beta1.TemplateVerificationDisabled),
+			HaveField("NewerTemplate", BeEmpty()),
+		))
+	})
+})
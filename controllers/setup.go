@@ -23,6 +23,7 @@ import (
 	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
 	"github.com/kyma-project/lifecycle-manager/pkg/index"
 	"github.com/kyma-project/lifecycle-manager/pkg/istio"
+	"github.com/kyma-project/lifecycle-manager/pkg/metrics"
 	"github.com/kyma-project/lifecycle-manager/pkg/watch"
 	listener "github.com/kyma-project/runtime-watcher/listener/pkg/event"
 )
@@ -31,10 +32,16 @@ type SetupUpSetting struct {
 	ListenerAddr                 string
 	EnableDomainNameVerification bool
 	IstioNamespace               string
+	// CTFRootPath is the directory CTF archives of air-gapped installations are read from.
+	CTFRootPath string
 }
 
 const (
-	WatcherControllerName = "watcher"
+	KymaControllerName           = "kyma"
+	ManifestControllerName       = "manifest"
+	WatcherControllerName        = "watcher"
+	ModuleRolloutControllerName  = "module-rollout"
+	ModuleTemplateControllerName = "module-template"
 )
 
 // SetupWithManager sets up the Kyma controller with the Manager.
@@ -47,6 +54,10 @@ func (r *KymaReconciler) SetupWithManager(mgr ctrl.Manager,
 			handler.EnqueueRequestsFromMapFunc(watch.NewTemplateChangeHandler(r).Watch(context.TODO())),
 			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
 		).
+		Watches(
+			&source.Kind{Type: &v1beta1.ModuleRollout{}},
+			handler.EnqueueRequestsFromMapFunc(watch.NewRolloutChangeHandler(r).Watch(context.TODO())),
+		).
 		// here we define a watch on secrets for the lifecycle-manager so that the cache is picking up changes
 		Watches(&source.Kind{Type: &corev1.Secret{}}, handler.Funcs{})
 
@@ -115,6 +126,7 @@ func (r *KymaReconciler) configureIndexing(ctx context.Context, mgr ctrl.Manager
 func (r *KymaReconciler) watchEventChannel(controllerBuilder *builder.Builder, eventChannel *source.Channel) {
 	controllerBuilder.Watches(eventChannel, &handler.Funcs{
 		GenericFunc: func(event event.GenericEvent, queue workqueue.RateLimitingInterface) {
+			metrics.RecordSKRListenerEvent(KymaControllerName)
 			ctrl.Log.WithName("listener").Info(
 				fmt.Sprintf("event coming from SKR, adding %s to queue",
 					client.ObjectKeyFromObject(event.Object).String()),
@@ -147,3 +159,38 @@ func (r *WatcherReconciler) SetupWithManager(mgr ctrl.Manager, options controlle
 		WithOptions(options).
 		Complete(r)
 }
+
+// SetupWithManager sets up the ModuleRollout controller with the Manager.
+func (r *ModuleRolloutReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1beta1.ModuleRollout{}).
+		Named(ModuleRolloutControllerName).
+		WithOptions(options).
+		Complete(r)
+}
+
+// SetupWithManager sets up the ModuleTemplate controller with the Manager.
+// It relies on the ModuleTemplate field indexes registered by the Kyma controller.
+func (r *ModuleTemplateReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+	if err := index.KymaTemplate().With(context.TODO(), mgr.GetFieldIndexer()); err != nil {
+		return fmt.Errorf(
+			"error while setting up Kyma ModuleTemplate Field Indexer, "+
+				"make sure you installed all CRDs: %w", err,
+		)
+	}
+	statusHandler := watch.NewTemplateStatusHandler(mgr.GetClient())
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1beta1.ModuleTemplate{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Named(ModuleTemplateControllerName).
+		WithOptions(options).
+		Watches(
+			&source.Kind{Type: &v1beta1.Kyma{}},
+			handler.EnqueueRequestsFromMapFunc(statusHandler.WatchKymas()),
+		).
+		Watches(
+			&source.Kind{Type: &v1beta1.ModuleTemplate{}},
+			handler.EnqueueRequestsFromMapFunc(statusHandler.WatchRelatedTemplates(context.TODO())),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Complete(r)
+}
@@ -1,10 +1,14 @@
 package main
 
 import (
+	"errors"
 	"flag"
+	"fmt"
 	"time"
 
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
 	"github.com/kyma-project/lifecycle-manager/pkg/log"
+	"github.com/kyma-project/lifecycle-manager/pkg/module/common"
 )
 
 const (
@@ -23,6 +27,8 @@ const (
 	defaultLogLevel                       = log.WarnLevel
 )
 
+var ErrInvalidFlagValue = errors.New("invalid flag value")
+
 //nolint:funlen
 func defineFlagVar() *FlagVar {
 	flagVar := new(FlagVar)
@@ -53,10 +59,16 @@ func defineFlagVar() *FlagVar {
 	flag.Float64Var(&flagVar.clientQPS, "k8s-client-qps", defaultClientQPS, "kubernetes client QPS")
 	flag.IntVar(&flagVar.clientBurst, "k8s-client-burst", defaultClientBurst, "kubernetes client Burst")
 	flag.StringVar(&flagVar.moduleVerificationKeyFilePath, "module-verification-key-file", "",
-		"This verification key is used to verify modules against their signature")
-	flag.StringVar(&flagVar.moduleVerificationKeyFilePath, "module-verification-signature-names",
+		"This verification key is used to verify modules against their signature. "+
+			"RSA, ECDSA and Ed25519 public keys in PKIX form are supported")
+	flag.StringVar(&flagVar.moduleVerificationCABundleFilePath, "module-verification-ca-bundle-file", "",
+		"Root certificates that certificate chains in module signatures are validated against, "+
+			"including their expiry and their key usage for code signing")
+	flag.StringVar(&flagVar.moduleVerificationSignatureNames, "module-verification-signature-names",
 		"kyma-module-signature:kyma-extension-signature",
 		"This verification key list is used to verify modules against their signature")
+	flag.BoolVar(&flagVar.enableModuleVerification, "enable-module-verification", false,
+		"Enabling verification of module signatures when templates are admitted and modules are installed")
 	flag.BoolVar(&flagVar.enableWebhooks, "enable-webhooks", false,
 		"Enabling Validation/Conversion Webhooks.")
 	flag.BoolVar(&flagVar.enableKcpWatcher, "enable-kcp-watcher", false,
@@ -95,10 +107,23 @@ func defineFlagVar() *FlagVar {
 		&flagVar.logLevel, "log-level", defaultLogLevel,
 		"indicates the current log-level, enter negative values to increase verbosity (e.g. 9)",
 	)
+	flag.StringVar(&flagVar.moduleDowngradePolicy, "module-downgrade-policy", string(common.DowngradePolicyBlock),
+		"Determines if modules may be downgraded to a lower version. "+
+			"Block keeps the installed version unless the Kyma allows the downgrade through an annotation, "+
+			"Allow applies downgrades like any other version change.")
+	flag.StringVar(&flagVar.templateResolutionStrategy, "template-resolution-strategy",
+		string(v1beta1.TemplateResolutionFail),
+		"Determines how a module template is resolved if several templates of a module are assigned to the same "+
+			"channel and the module does not declare a strategy itself. "+
+			"Fail reports an error, HighestVersion picks the highest semantic version, "+
+			"Newest picks the template created last.")
 	flag.BoolVar(
 		&flagVar.insecureRegistry, "insecure-registry", false,
 		"indicates if insecure (http) response is expected from image registry",
 	)
+	flag.StringVar(&flagVar.ctfRootPath, "ctf-root-path", "",
+		"Directory that common transport format (CTF) archives of air-gapped installations are read from. "+
+			"Modules referencing a CTF archive cannot be installed if it is not set.")
 	return flagVar
 }
 
@@ -112,6 +137,8 @@ type FlagVar struct {
 	manifestRequeueSuccessInterval                                  time.Duration
 	watcherRequeueSuccessInterval                                   time.Duration
 	moduleVerificationKeyFilePath, moduleVerificationSignatureNames string
+	moduleVerificationCABundleFilePath                              string
+	enableModuleVerification                                        bool
 	clientQPS                                                       float64
 	clientBurst                                                     int
 	enableWebhooks                                                  bool
@@ -136,4 +163,25 @@ type FlagVar struct {
 	enableDomainNameVerification           bool
 	logLevel                               int
 	insecureRegistry                       bool
+	moduleDowngradePolicy                  string
+	templateResolutionStrategy             string
+	ctfRootPath                            string
+}
+
+// validate rejects flag values that would otherwise only be noticed during reconciliation.
+func (f *FlagVar) validate() error {
+	switch common.DowngradePolicy(f.moduleDowngradePolicy) {
+	case common.DowngradePolicyBlock, common.DowngradePolicyAllow:
+	default:
+		return fmt.Errorf("%w: module-downgrade-policy must be %s or %s, got %q", ErrInvalidFlagValue,
+			common.DowngradePolicyBlock, common.DowngradePolicyAllow, f.moduleDowngradePolicy)
+	}
+	switch v1beta1.TemplateResolutionStrategy(f.templateResolutionStrategy) {
+	case v1beta1.TemplateResolutionFail, v1beta1.TemplateResolutionHighestVersion, v1beta1.TemplateResolutionNewest:
+	default:
+		return fmt.Errorf("%w: template-resolution-strategy must be %s, %s or %s, got %q", ErrInvalidFlagValue,
+			v1beta1.TemplateResolutionFail, v1beta1.TemplateResolutionHighestVersion, v1beta1.TemplateResolutionNewest,
+			f.templateResolutionStrategy)
+	}
+	return nil
 }
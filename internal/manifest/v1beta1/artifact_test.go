@@ -0,0 +1,91 @@
+package v1beta1_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/uuid"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+	internalv1beta1 "github.com/kyma-project/lifecycle-manager/internal/manifest/v1beta1"
+)
+
+var _ = Describe("extracting charts of OCI artifacts pulled by tag", func() {
+	var host string
+
+	BeforeEach(func() {
+		artifactRegistry := httptest.NewServer(registry.New())
+		DeferCleanup(artifactRegistry.Close)
+		registryURL, err := url.Parse(artifactRegistry.URL)
+		Expect(err).ToNot(HaveOccurred())
+		host = registryURL.Host
+	})
+
+	pushChart := func(repository, description string) v1beta1.ImageSpec {
+		var archive bytes.Buffer
+		gzipWriter := gzip.NewWriter(&archive)
+		tarWriter := tar.NewWriter(gzipWriter)
+		chart := []byte(fmt.Sprintf("apiVersion: v2\nname: sample\nversion: 1.0.0\ndescription: %s\n", description))
+		Expect(tarWriter.WriteHeader(&tar.Header{
+			Name: "Chart.yaml", Mode: 0o600, Size: int64(len(chart)), Typeflag: tar.TypeReg,
+		})).To(Succeed())
+		_, err := tarWriter.Write(chart)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tarWriter.Close()).To(Succeed())
+		Expect(gzipWriter.Close()).To(Succeed())
+
+		layer, err := tarball.LayerFromReader(bytes.NewReader(archive.Bytes()))
+		Expect(err).ToNot(HaveOccurred())
+		image, err := mutate.AppendLayers(empty.Image, layer)
+		Expect(err).ToNot(HaveOccurred())
+		imageSpec := v1beta1.ImageSpec{
+			Repo: host + "/" + repository, Name: "sample", Ref: "1.0.0", Type: v1beta1.OciArtifactType,
+		}
+		Expect(crane.Push(image, fmt.Sprintf("%s/sample:1.0.0", imageSpec.Repo))).To(Succeed())
+		return imageSpec
+	}
+
+	extractedDescription := func(imageSpec v1beta1.ImageSpec) string {
+		chartPath, err := internalv1beta1.GetPathFromExtractedTarGz(
+			context.TODO(), imageSpec, true, authn.DefaultKeychain, "")
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(os.RemoveAll, chartPath)
+		DeferCleanup(os.RemoveAll, chartPath+".digest")
+		chart, err := os.ReadFile(filepath.Join(chartPath, "Chart.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		return string(chart)
+	}
+
+	It("should not share the chart of the same name and tag in different repositories", func() {
+		team := string(uuid.NewUUID())
+		first := pushChart(team+"/first", "first")
+		second := pushChart(team+"/second", "second")
+
+		Expect(extractedDescription(first)).To(ContainSubstring("description: first"))
+		Expect(extractedDescription(second)).To(ContainSubstring("description: second"))
+	})
+
+	It("should pull the chart again once the tag is moved", func() {
+		repository := string(uuid.NewUUID())
+		imageSpec := pushChart(repository, "before")
+		Expect(extractedDescription(imageSpec)).To(ContainSubstring("description: before"))
+
+		pushChart(repository, "after")
+		Expect(extractedDescription(imageSpec)).To(ContainSubstring("description: after"))
+	})
+})
@@ -0,0 +1,91 @@
+package v1beta1
+
+import (
+	"archive/tar"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+	"github.com/kyma-project/lifecycle-manager/internal"
+)
+
+const ctfBlobsFolder = "blobs"
+
+var (
+	ErrCTFDisabled     = errors.New("installing from CTF archives is disabled, as no CTF root path is configured")
+	ErrCTFBlobNotFound = errors.New("blob not found in CTF archive")
+)
+
+// openCTFBlob opens the blob referenced by the image spec in a common transport format archive below root.
+// The archive is either an extracted directory or a (gzipped) tar, both storing blobs as blobs/<algorithm>.<hex>.
+func openCTFBlob(root string, imageSpec v1beta1.ImageSpec, hash v1.Hash) (io.ReadCloser, error) {
+	if root == "" {
+		return nil, ErrCTFDisabled
+	}
+	archivePath, err := internal.CleanFilePathJoin(root, imageSpec.Repo)
+	if err != nil {
+		return nil, fmt.Errorf("resolving CTF archive %s: %w", imageSpec.Repo, err)
+	}
+	blobName := path.Join(ctfBlobsFolder, fmt.Sprintf("%s.%s", hash.Algorithm, hash.Hex))
+
+	info, err := os.Stat(archivePath)
+	if err != nil {
+		return nil, fmt.Errorf("opening CTF archive %s: %w", imageSpec.Repo, err)
+	}
+	if info.IsDir() {
+		blobPath, err := internal.CleanFilePathJoin(archivePath, blobName)
+		if err != nil {
+			return nil, err
+		}
+		blob, err := os.Open(blobPath)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s in %s: %s", ErrCTFBlobNotFound, blobName, imageSpec.Repo, err.Error())
+		}
+		return blob, nil
+	}
+	return openTarEntry(archivePath, blobName)
+}
+
+// openTarEntry returns a reader for the entry with the given name in a tar, which may be gzipped.
+func openTarEntry(archivePath, name string) (io.ReadCloser, error) {
+	file, err := os.Open(archivePath)
+	if err != nil {
+		return nil, fmt.Errorf("opening CTF archive %s: %w", archivePath, err)
+	}
+	reader, err := decompressIfGzipped(file)
+	if err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("decompressing CTF archive %s: %w", archivePath, err)
+	}
+
+	tarReader := tar.NewReader(reader)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			_ = file.Close()
+			return nil, fmt.Errorf("%w: %s in %s", ErrCTFBlobNotFound, name, archivePath)
+		}
+		if err != nil {
+			_ = file.Close()
+			return nil, fmt.Errorf("reading CTF archive %s: %w", archivePath, err)
+		}
+		if path.Clean(header.Name) == name {
+			return &tarEntryReader{Reader: tarReader, file: file}, nil
+		}
+	}
+}
+
+// tarEntryReader reads a single entry of a tar and closes the underlying archive once done.
+type tarEntryReader struct {
+	io.Reader
+	file *os.File
+}
+
+func (r *tarEntryReader) Close() error {
+	return r.file.Close()
+}
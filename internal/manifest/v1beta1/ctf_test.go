@@ -0,0 +1,89 @@
+package v1beta1_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/uuid"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+	internalv1beta1 "github.com/kyma-project/lifecycle-manager/internal/manifest/v1beta1"
+)
+
+var _ = Describe("reading layers from CTF archives", func() {
+	var root string
+	var content []byte
+	var blob v1.Hash
+
+	BeforeEach(func() {
+		root = GinkgoT().TempDir()
+		content = []byte("name: " + string(uuid.NewUUID()) + "\n")
+		var err error
+		blob, _, err = v1.SHA256(bytes.NewReader(content))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	ctfSpec := func(archive string) v1beta1.ImageSpec {
+		return v1beta1.ImageSpec{Repo: archive, Name: "kyma-project.io/module/sample", Ref: blob.String(),
+			Type: v1beta1.CTFRefType}
+	}
+	blobName := func() string {
+		return filepath.Join("blobs", blob.Algorithm+"."+blob.Hex)
+	}
+
+	It("should read the config from an extracted archive", func() {
+		Expect(os.MkdirAll(filepath.Join(root, "sample", "blobs"), os.ModePerm)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(root, "sample", blobName()), content, os.ModePerm)).To(Succeed())
+
+		config, err := internalv1beta1.DecodeUncompressedYAMLLayer(
+			context.TODO(), ctfSpec("sample"), false, nil, root)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config).To(HaveKey("name"))
+	})
+
+	It("should read the config from a gzipped tar", func() {
+		file, err := os.Create(filepath.Join(root, "sample.tgz"))
+		Expect(err).ToNot(HaveOccurred())
+		gzipWriter := gzip.NewWriter(file)
+		tarWriter := tar.NewWriter(gzipWriter)
+		Expect(tarWriter.WriteHeader(&tar.Header{
+			Name: blobName(), Mode: 0o600, Size: int64(len(content)), Typeflag: tar.TypeReg,
+		})).To(Succeed())
+		_, err = tarWriter.Write(content)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tarWriter.Close()).To(Succeed())
+		Expect(gzipWriter.Close()).To(Succeed())
+		Expect(file.Close()).To(Succeed())
+
+		config, err := internalv1beta1.DecodeUncompressedYAMLLayer(
+			context.TODO(), ctfSpec("sample.tgz"), false, nil, root)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config).To(HaveKey("name"))
+	})
+
+	It("should not read archives outside of the root", func() {
+		_, err := internalv1beta1.DecodeUncompressedYAMLLayer(
+			context.TODO(), ctfSpec("../sample"), false, nil, root)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should not read archives if no root is configured", func() {
+		_, err := internalv1beta1.DecodeUncompressedYAMLLayer(
+			context.TODO(), ctfSpec("sample"), false, nil, "")
+		Expect(err).To(MatchError(internalv1beta1.ErrCTFDisabled))
+	})
+
+	It("should report blobs missing in the archive", func() {
+		Expect(os.MkdirAll(filepath.Join(root, "sample", "blobs"), os.ModePerm)).To(Succeed())
+		_, err := internalv1beta1.DecodeUncompressedYAMLLayer(
+			context.TODO(), ctfSpec("sample"), false, nil, root)
+		Expect(err).To(MatchError(internalv1beta1.ErrCTFBlobNotFound))
+	})
+})
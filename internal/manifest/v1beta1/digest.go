@@ -0,0 +1,227 @@
+package v1beta1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+)
+
+var (
+	ErrLayerDigestMismatch    = errors.New("layer content does not match its digest")
+	ErrDigestAlgorithmUnknown = errors.New("digest algorithm is not supported")
+)
+
+// digestVerifier hashes the content of a layer while it is read, so that it can be compared against the digest
+// of the layer once it is consumed.
+type digestVerifier struct {
+	reader   io.Reader
+	hash     hash.Hash
+	expected v1.Hash
+}
+
+func newDigestVerifier(reader io.Reader, expected v1.Hash) (*digestVerifier, error) {
+	if expected.Algorithm != "sha256" {
+		return nil, fmt.Errorf("%w: %s", ErrDigestAlgorithmUnknown, expected.Algorithm)
+	}
+	verifier := &digestVerifier{hash: sha256.New(), expected: expected}
+	verifier.reader = io.TeeReader(reader, verifier.hash)
+	return verifier, nil
+}
+
+func (v *digestVerifier) Read(p []byte) (int, error) {
+	return v.reader.Read(p)
+}
+
+// Verify reads the remaining content, e.g. padding behind the end of an archive, and compares the digest.
+func (v *digestVerifier) Verify() error {
+	if _, err := io.Copy(io.Discard, v.reader); err != nil {
+		return fmt.Errorf("reading layer %s for digest verification: %w", v.expected, err)
+	}
+	if actual := hex.EncodeToString(v.hash.Sum(nil)); actual != v.expected.Hex {
+		return fmt.Errorf("%w: expected %s but got sha256:%s", ErrLayerDigestMismatch, v.expected, actual)
+	}
+	return nil
+}
+
+// cacheRecord is persisted next to a cached file or directory once it was written from a verified layer,
+// so that the cache can still be verified after a restart.
+type cacheRecord struct {
+	// Ref is the digest the layer was pulled by.
+	Ref string `json:"ref"`
+	// Content is the digest of the cached content, see contentDigest.
+	Content string `json:"content"`
+}
+
+func cacheRecordPath(cachePath string) string {
+	return cachePath + ".digest"
+}
+
+// cacheLocks serialises the verification and writing of each cache path across concurrent reconciliations.
+var cacheLocks sync.Map //nolint:gochecknoglobals
+
+// lockCache locks the cache path and returns the function to unlock it.
+func lockCache(cachePath string) func() {
+	lock, _ := cacheLocks.LoadOrStore(cachePath, &sync.Mutex{})
+	mutex, _ := lock.(*sync.Mutex)
+	mutex.Lock()
+	return mutex.Unlock
+}
+
+// verifiedFingerprints remembers the metadata of cached content after its digest was verified, so that
+// the content is only hashed again once its metadata changed.
+var verifiedFingerprints sync.Map //nolint:gochecknoglobals
+
+// verifyCache returns true if the cached file or directory exists and is unchanged since it was written
+// from the layer pulled by ref. Content without a record of this layer cannot be verified and is removed,
+// content modified since it was written is removed and refused. The cache path has to be locked.
+func verifyCache(cachePath, ref string) (bool, error) {
+	if _, err := os.Lstat(cachePath); os.IsNotExist(err) {
+		verifiedFingerprints.Delete(cachePath)
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("opening cached layer %s: %w", cachePath, err)
+	}
+
+	record, err := readCacheRecord(cachePath)
+	if err != nil || record.Ref != ref {
+		return false, removeCache(cachePath)
+	}
+	fingerprint, err := contentFingerprint(cachePath)
+	if err != nil {
+		return false, err
+	}
+	if verified, ok := verifiedFingerprints.Load(cachePath); ok && verified == fingerprint {
+		return true, nil
+	}
+	actual, err := contentDigest(cachePath)
+	if err != nil {
+		return false, err
+	}
+	if actual != record.Content {
+		if err := removeCache(cachePath); err != nil {
+			return false, err
+		}
+		return false, fmt.Errorf("%w: cached layer %s was modified", ErrLayerDigestMismatch, cachePath)
+	}
+	verifiedFingerprints.Store(cachePath, fingerprint)
+	return true, nil
+}
+
+// commitCache moves the content written from the verified layer pulled by ref into the cache path,
+// and records its digest. The cache path has to be locked.
+func commitCache(writtenPath, cachePath, ref string) error {
+	digest, err := contentDigest(writtenPath)
+	if err != nil {
+		return err
+	}
+	if err := removeCache(cachePath); err != nil {
+		return err
+	}
+	if err := os.Rename(writtenPath, cachePath); err != nil {
+		return fmt.Errorf("moving layer into cache %s: %w", cachePath, err)
+	}
+	record, err := json.Marshal(cacheRecord{Ref: ref, Content: digest})
+	if err != nil {
+		return err
+	}
+	recordFile, err := os.CreateTemp(filepath.Dir(cachePath), filepath.Base(cachePath)+".digest-*")
+	if err != nil {
+		return fmt.Errorf("recording digest of cached layer %s: %w", cachePath, err)
+	}
+	_, err = recordFile.Write(record)
+	if closeErr := recordFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(recordFile.Name(), cacheRecordPath(cachePath))
+	}
+	if err != nil {
+		_ = os.Remove(recordFile.Name())
+		return fmt.Errorf("recording digest of cached layer %s: %w", cachePath, err)
+	}
+	return nil
+}
+
+func readCacheRecord(cachePath string) (*cacheRecord, error) {
+	data, err := os.ReadFile(cacheRecordPath(cachePath))
+	if err != nil {
+		return nil, err
+	}
+	record := &cacheRecord{}
+	if err := json.Unmarshal(data, record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+func removeCache(cachePath string) error {
+	verifiedFingerprints.Delete(cachePath)
+	if err := os.Remove(cacheRecordPath(cachePath)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("removing digest of cached layer %s: %w", cachePath, err)
+	}
+	if err := os.RemoveAll(cachePath); err != nil {
+		return fmt.Errorf("removing unverified cached layer %s: %w", cachePath, err)
+	}
+	return nil
+}
+
+// contentDigest hashes a file or the paths, types and contents of all entries of a directory in lexical order.
+func contentDigest(root string) (string, error) {
+	digest := sha256.New()
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		relative, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(digest, "%s\x00%s\x00", filepath.ToSlash(relative), entry.Type())
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		_, err = io.Copy(digest, file)
+		return err
+	})
+	if err != nil {
+		return "", fmt.Errorf("hashing cached layer %s: %w", root, err)
+	}
+	return hex.EncodeToString(digest.Sum(nil)), nil
+}
+
+// contentFingerprint hashes the paths, modes, sizes and modification times of a file or all entries
+// of a directory, which is much cheaper than hashing their content.
+func contentFingerprint(root string) (string, error) {
+	fingerprint := sha256.New()
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(fingerprint, "%s\x00%s\x00%d\x00%d\x00",
+			path, info.Mode(), info.Size(), info.ModTime().UnixNano())
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("reading cached layer %s: %w", root, err)
+	}
+	return hex.EncodeToString(fingerprint.Sum(nil)), nil
+}
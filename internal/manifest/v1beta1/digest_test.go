@@ -0,0 +1,148 @@
+package v1beta1_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/uuid"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+	internalv1beta1 "github.com/kyma-project/lifecycle-manager/internal/manifest/v1beta1"
+)
+
+var _ = Describe("verifying layer digests", func() {
+	var root string
+
+	BeforeEach(func() {
+		root = GinkgoT().TempDir()
+		Expect(os.MkdirAll(filepath.Join(root, "sample", "blobs"), os.ModePerm)).To(Succeed())
+	})
+
+	writeBlob := func(content []byte) v1beta1.ImageSpec {
+		blob, _, err := v1.SHA256(bytes.NewReader(content))
+		Expect(err).ToNot(HaveOccurred())
+		blobPath := filepath.Join(root, "sample", "blobs", blob.Algorithm+"."+blob.Hex)
+		Expect(os.WriteFile(blobPath, content, os.ModePerm)).To(Succeed())
+		return v1beta1.ImageSpec{Repo: "sample", Name: "kyma-project.io/module/" + string(uuid.NewUUID()),
+			Ref: blob.String(), Type: v1beta1.CTFRefType}
+	}
+
+	It("should refuse a layer that does not match its digest", func() {
+		imageSpec := writeBlob([]byte("name: sample\n"))
+		blob, err := v1.NewHash(imageSpec.Ref)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(os.WriteFile(filepath.Join(root, "sample", "blobs", blob.Algorithm+"."+blob.Hex),
+			[]byte("name: tampered\n"), os.ModePerm)).To(Succeed())
+
+		_, err = internalv1beta1.DecodeUncompressedYAMLLayer(context.TODO(), imageSpec, false, nil, root)
+		Expect(err).To(MatchError(internalv1beta1.ErrLayerDigestMismatch))
+		Expect(internalv1beta1.GetConfigFilePath(imageSpec)).ToNot(BeAnExistingFile())
+	})
+
+	It("should refuse a cached config that was modified and pull it again", func() {
+		imageSpec := writeBlob([]byte("name: " + string(uuid.NewUUID()) + "\n"))
+		_, err := internalv1beta1.DecodeUncompressedYAMLLayer(context.TODO(), imageSpec, false, nil, root)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(os.RemoveAll, filepath.Dir(internalv1beta1.GetConfigFilePath(imageSpec)))
+
+		Expect(os.WriteFile(internalv1beta1.GetConfigFilePath(imageSpec),
+			[]byte("name: tampered\n"), os.ModePerm)).To(Succeed())
+		_, err = internalv1beta1.DecodeUncompressedYAMLLayer(context.TODO(), imageSpec, false, nil, root)
+		Expect(err).To(MatchError(internalv1beta1.ErrLayerDigestMismatch))
+		Expect(internalv1beta1.GetConfigFilePath(imageSpec)).ToNot(BeAnExistingFile())
+
+		config, err := internalv1beta1.DecodeUncompressedYAMLLayer(context.TODO(), imageSpec, false, nil, root)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config).To(HaveKeyWithValue("name", Not(Equal("tampered"))))
+	})
+
+	writeChart := func() v1beta1.ImageSpec {
+		var archive bytes.Buffer
+		gzipWriter := gzip.NewWriter(&archive)
+		tarWriter := tar.NewWriter(gzipWriter)
+		chart := []byte("apiVersion: v2\nname: sample\nversion: 0.1.0\n")
+		Expect(tarWriter.WriteHeader(&tar.Header{
+			Name: "Chart.yaml", Mode: 0o600, Size: int64(len(chart)), Typeflag: tar.TypeReg,
+		})).To(Succeed())
+		_, err := tarWriter.Write(chart)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tarWriter.Close()).To(Succeed())
+		Expect(gzipWriter.Close()).To(Succeed())
+		imageSpec := writeBlob(archive.Bytes())
+		DeferCleanup(os.RemoveAll, internalv1beta1.GetFsChartPath(imageSpec))
+		DeferCleanup(os.RemoveAll, internalv1beta1.GetFsChartPath(imageSpec)+".digest")
+		return imageSpec
+	}
+
+	It("should refuse an extracted chart that was modified and extract it again", func() {
+		imageSpec := writeChart()
+
+		chartPath, err := internalv1beta1.GetPathFromExtractedTarGz(context.TODO(), imageSpec, false, nil, root)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(filepath.Join(chartPath, "Chart.yaml")).To(BeAnExistingFile())
+
+		Expect(os.Remove(filepath.Join(chartPath, "Chart.yaml"))).To(Succeed())
+		_, err = internalv1beta1.GetPathFromExtractedTarGz(context.TODO(), imageSpec, false, nil, root)
+		Expect(err).To(MatchError(internalv1beta1.ErrLayerDigestMismatch))
+
+		chartPath, err = internalv1beta1.GetPathFromExtractedTarGz(context.TODO(), imageSpec, false, nil, root)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(filepath.Join(chartPath, "Chart.yaml")).To(BeAnExistingFile())
+	})
+
+	It("should keep a verified chart without pulling the layer again", func() {
+		imageSpec := writeChart()
+		chartPath, err := internalv1beta1.GetPathFromExtractedTarGz(context.TODO(), imageSpec, false, nil, root)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(os.RemoveAll(filepath.Join(root, "sample", "blobs"))).To(Succeed())
+		cachedPath, err := internalv1beta1.GetPathFromExtractedTarGz(context.TODO(), imageSpec, false, nil, root)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cachedPath).To(Equal(chartPath))
+		Expect(filepath.Join(cachedPath, "Chart.yaml")).To(BeAnExistingFile())
+	})
+
+	It("should replace a chart that was not extracted from a verified layer", func() {
+		imageSpec := writeChart()
+		leftover := filepath.Join(internalv1beta1.GetFsChartPath(imageSpec), "leftover.yaml")
+		Expect(os.MkdirAll(filepath.Dir(leftover), os.ModePerm)).To(Succeed())
+		Expect(os.WriteFile(leftover, []byte("leftover: true\n"), os.ModePerm)).To(Succeed())
+
+		chartPath, err := internalv1beta1.GetPathFromExtractedTarGz(context.TODO(), imageSpec, false, nil, root)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(filepath.Join(chartPath, "Chart.yaml")).To(BeAnExistingFile())
+		Expect(leftover).ToNot(BeAnExistingFile())
+	})
+
+	It("should extract a chart once for concurrent reconciliations", func() {
+		imageSpec := writeChart()
+		var group sync.WaitGroup
+		errs := make(chan error, 8)
+		for i := 0; i < cap(errs); i++ {
+			group.Add(1)
+			go func() {
+				defer group.Done()
+				_, err := internalv1beta1.GetPathFromExtractedTarGz(context.TODO(), imageSpec, false, nil, root)
+				errs <- err
+			}()
+		}
+		group.Wait()
+		close(errs)
+		for err := range errs {
+			Expect(err).ToNot(HaveOccurred())
+		}
+		entries, err := os.ReadDir(filepath.Dir(internalv1beta1.GetFsChartPath(imageSpec)))
+		Expect(err).ToNot(HaveOccurred())
+		for _, entry := range entries {
+			Expect(entry.Name()).ToNot(ContainSubstring(".extract-"))
+		}
+	})
+})
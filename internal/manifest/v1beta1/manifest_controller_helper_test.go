@@ -131,6 +131,12 @@ func NewTestManifest(prefix string) *v1beta1.Manifest {
 	}
 }
 
+func removeExtractedChart(name string, ociLayerType OCILayerType) {
+	imageSpec := createOCIImageSpec(name, server.Listener.Addr().String(), ociLayerType)
+	Expect(os.RemoveAll(internalv1beta1.GetFsChartPath(imageSpec))).To(Succeed())
+	Expect(os.RemoveAll(internalv1beta1.GetFsChartPath(imageSpec) + ".digest")).To(Succeed())
+}
+
 func deleteHelmChartResources(imageSpec v1beta1.ImageSpec) {
 	chartYamlPath := filepath.Join(internalv1beta1.GetFsChartPath(imageSpec), "Chart.yaml")
 	Expect(os.RemoveAll(chartYamlPath)).Should(Succeed())
@@ -140,11 +146,11 @@ func deleteHelmChartResources(imageSpec v1beta1.ImageSpec) {
 	Expect(os.RemoveAll(templatesPath)).Should(Succeed())
 }
 
-func verifyHelmResourcesDeletion(imageSpec v1beta1.ImageSpec) {
-	_, err := os.Stat(filepath.Join(internalv1beta1.GetFsChartPath(imageSpec), "Chart.yaml"))
-	Expect(os.IsNotExist(err)).To(BeTrue())
-	_, err = os.Stat(filepath.Join(internalv1beta1.GetFsChartPath(imageSpec), "values.yaml"))
-	Expect(os.IsNotExist(err)).To(BeTrue())
-	_, err = os.Stat(filepath.Join(internalv1beta1.GetFsChartPath(imageSpec), "templates"))
-	Expect(os.IsNotExist(err)).To(BeTrue())
+func verifyHelmResourcesRestored(imageSpec v1beta1.ImageSpec) error {
+	for _, name := range []string{"Chart.yaml", "values.yaml", "templates"} {
+		if _, err := os.Stat(filepath.Join(internalv1beta1.GetFsChartPath(imageSpec), name)); err != nil {
+			return err
+		}
+	}
+	return nil
 }
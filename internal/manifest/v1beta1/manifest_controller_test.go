@@ -13,6 +13,7 @@ import (
 	declarative "github.com/kyma-project/lifecycle-manager/pkg/declarative/v2"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	errors2 "k8s.io/apimachinery/pkg/api/errors"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -128,7 +129,8 @@ var _ = Describe(
 		)
 		BeforeEach(
 			func() {
-				Expect(os.RemoveAll(filepath.Join(os.TempDir(), mainOciTempDir))).To(Succeed())
+				removeExtractedChart(installName, layerInstalls)
+				removeExtractedChart(crdName, layerCRDs)
 			},
 		)
 		DescribeTable(
@@ -219,7 +221,7 @@ var _ = Describe(
 		)
 		BeforeEach(
 			func() {
-				Expect(os.RemoveAll(filepath.Join(os.TempDir(), mainOciTempDir))).To(Succeed())
+				removeExtractedChart(installName, layerInstalls)
 			},
 		)
 		It(
@@ -237,8 +239,9 @@ var _ = Describe(
 				manifest2WithInstall.Labels[labels.KymaName] = manifestWithInstall.Labels[labels.KymaName]
 				Eventually(withValidInstallImageSpec(installName, false), standardTimeout, standardInterval).
 					WithArguments(manifest2WithInstall).Should(Succeed())
-				// verify no new Helm resources were created
-				verifyHelmResourcesDeletion(validImageSpec)
+				// verify the modified chart is refused and extracted again from the verified layer
+				Eventually(verifyHelmResourcesRestored, standardTimeout, standardInterval).
+					WithArguments(validImageSpec).Should(Succeed())
 				// fresh Manifest with empty installs
 				Eventually(
 					deleteManifestAndVerify(manifestWithInstall), standardTimeout, standardInterval,
@@ -251,6 +254,58 @@ var _ = Describe(
 	},
 )
 
+var _ = Describe(
+	"Given Manifest CR that orphans its resources", func() {
+		installName := filepath.Join("orphan", "installs")
+		It(
+			"setup remote oci Registry",
+			func() {
+				PushToRemoteOCIRegistry(installName, layerInstalls)
+			},
+		)
+		BeforeEach(
+			func() {
+				removeExtractedChart(installName, layerInstalls)
+			},
+		)
+		It(
+			"should keep the resources in the remote cluster after the Manifest is deleted", func() {
+				manifest := NewTestManifest("orphan")
+				Eventually(withValidInstallImageSpec(installName, true), standardTimeout, standardInterval).
+					WithArguments(manifest).Should(Succeed())
+				Eventually(expectManifestStateIn(declarative.StateReady), standardTimeout, standardInterval).
+					WithArguments(manifest.GetName()).Should(Succeed())
+				Eventually(expectChartServiceExists, standardTimeout, standardInterval).Should(Succeed())
+
+				By("annotating the Manifest to orphan its resources, as the Kyma deletion with the Orphan policy does")
+				Eventually(orphanManifestResources, standardTimeout, standardInterval).
+					WithArguments(manifest).Should(Succeed())
+				Eventually(deleteManifestAndVerify(manifest), standardTimeout, standardInterval).Should(Succeed())
+
+				Consistently(expectChartServiceExists, standardTimeout/10, standardInterval).Should(Succeed())
+			},
+		)
+	},
+)
+
+func expectChartServiceExists() error {
+	return k8sClient.Get(ctx, client.ObjectKey{Name: "busybox-pod", Namespace: v1.NamespaceDefault}, &corev1.Service{})
+}
+
+func orphanManifestResources(manifest *v1beta1.Manifest) error {
+	current := &v1beta1.Manifest{}
+	if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(manifest), current); err != nil {
+		return err
+	}
+	annotations := current.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[v1beta1.OrphanResourcesAnnotation] = "true"
+	current.SetAnnotations(annotations)
+	return k8sClient.Update(ctx, current)
+}
+
 func skipExpect() func() bool {
 	return func() bool {
 		return true
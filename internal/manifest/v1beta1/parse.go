@@ -2,6 +2,8 @@ package v1beta1
 
 import (
 	"archive/tar"
+	"bufio"
+	"bytes"
 	"compress/gzip"
 	"context"
 	"errors"
@@ -10,6 +12,8 @@ import (
 	"io/fs"
 	"os"
 	"path"
+	"path/filepath"
+	"strings"
 
 	v1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
@@ -27,38 +31,51 @@ func GetPathFromExtractedTarGz(
 	imageSpec v1beta1.ImageSpec,
 	insecureRegistry bool,
 	keyChain authn.Keychain,
+	ctfRoot string,
 ) (string, error) {
-	imageRef := fmt.Sprintf("%s/%s@%s", imageSpec.Repo, imageSpec.Name, imageSpec.Ref)
+	imageSpec, err := resolveArtifactDigest(ctx, imageSpec, insecureRegistry, keyChain)
+	if err != nil {
+		return "", err
+	}
+	imageRef := imageReference(imageSpec)
 
-	// check existing dir
-	// if dir exists return existing dir
+	// use the extracted chart if it is unchanged since it was extracted from a verified layer
 	installPath := GetFsChartPath(imageSpec)
-	dir, err := os.Open(installPath)
-	if err != nil && !os.IsNotExist(err) {
-		return "", fmt.Errorf("opening dir for installs caused an error %s: %w", imageRef, err)
-	}
-	if dir != nil {
-		return installPath, nil
+	unlock := lockCache(installPath)
+	defer unlock()
+	if cached, err := verifyCache(installPath, imageSpec.Ref); err != nil || cached {
+		return installPath, err
 	}
 
 	// pull image layer
-	layer, err := pullLayer(ctx, insecureRegistry, imageRef, keyChain)
+	blob, verifier, err := fetchBlob(ctx, imageSpec, insecureRegistry, keyChain, ctfRoot)
 	if err != nil {
 		return "", err
 	}
+	defer blob.Close()
 
-	// uncompress chart to install path
-	blobReadCloser, err := layer.Compressed()
+	// uncompress chart next to the install path, and move it there once the layer is verified
+	if err := os.MkdirAll(filepath.Dir(installPath), fs.ModePerm); err != nil {
+		return "", fmt.Errorf("creating cache for %s: %w", imageRef, err)
+	}
+	extractPath, err := os.MkdirTemp(filepath.Dir(installPath), filepath.Base(installPath)+".extract-*")
 	if err != nil {
-		return "", fmt.Errorf("fetching blob for compressed layer %s: %w", imageRef, err)
+		return "", fmt.Errorf("creating cache for %s: %w", imageRef, err)
 	}
+	defer os.RemoveAll(extractPath)
 
-	uncompressedStream, err := gzip.NewReader(blobReadCloser)
+	uncompressedStream, err := gzip.NewReader(verifier)
 	if err != nil {
 		return "", fmt.Errorf("failure in NewReader() while extracting TarGz %s: %w", imageRef, err)
 	}
 	tarReader := tar.NewReader(uncompressedStream)
-	return installPath, writeTarGzContent(installPath, tarReader, imageRef)
+	if err := writeTarGzContent(extractPath, tarReader, imageRef); err != nil {
+		return "", err
+	}
+	if err := verifier.Verify(); err != nil {
+		return "", fmt.Errorf("verifying layer %s: %w", imageRef, err)
+	}
+	return installPath, commitCache(extractPath, installPath, imageSpec.Ref)
 }
 
 func writeTarGzContent(installPath string, tarReader *tar.Reader, layerReference string) error {
@@ -98,7 +115,13 @@ func writeTarGzContent(installPath string, tarReader *tar.Reader, layerReference
 	return nil
 }
 
-var ErrUnknownTypeDuringHeaderExtraction = errors.New("unknown type encountered during header extraction")
+//nolint:gochecknoglobals
+var gzipMagic = []byte{0x1f, 0x8b}
+
+var (
+	ErrUnknownTypeDuringHeaderExtraction = errors.New("unknown type encountered during header extraction")
+	ErrArtifactLayerAmbiguous            = errors.New("artifact does not consist of exactly one layer")
+)
 
 func handleExtractedHeaderFile(
 	header *tar.Header,
@@ -135,51 +158,196 @@ func DecodeUncompressedYAMLLayer(
 	imageSpec v1beta1.ImageSpec,
 	insecureRegistry bool,
 	keyChain authn.Keychain,
+	ctfRoot string,
 ) (interface{}, error) {
+	imageSpec, err := resolveArtifactDigest(ctx, imageSpec, insecureRegistry, keyChain)
+	if err != nil {
+		return nil, err
+	}
 	configFilePath := GetConfigFilePath(imageSpec)
+	unlock := lockCache(configFilePath)
+	defer unlock()
 
-	imageRef := fmt.Sprintf("%s/%s@%s", imageSpec.Repo, imageSpec.Name, imageSpec.Ref)
-	// check existing file
-	decodedFile, err := internal.GetYamlFileContent(configFilePath)
-	if err == nil {
+	imageRef := imageReference(imageSpec)
+	// use the config if it is unchanged since it was written from a verified layer
+	if cached, err := verifyCache(configFilePath, imageSpec.Ref); err != nil {
+		return nil, err
+	} else if cached {
+		decodedFile, err := internal.GetYamlFileContent(configFilePath)
+		if err != nil {
+			return nil, fmt.Errorf("opening file for install imageSpec caused an error %s: %w", imageRef, err)
+		}
 		return decodedFile, nil
-	} else if !os.IsNotExist(err) {
-		return nil, fmt.Errorf("opening file for install imageSpec caused an error %s: %w", imageRef, err)
 	}
 
 	// proceed only if file was not found
-	// yaml is not compressed
-	layer, err := pullLayer(ctx, insecureRegistry, imageRef, keyChain)
+	blob, verifier, err := fetchBlob(ctx, imageSpec, insecureRegistry, keyChain, ctfRoot)
 	if err != nil {
 		return nil, err
 	}
-	blob, err := layer.Uncompressed()
+	defer blob.Close()
+
+	// yaml is usually not compressed, but is decompressed if it is
+	uncompressed, err := decompressIfGzipped(verifier)
 	if err != nil {
 		return nil, fmt.Errorf("fetching blob for uncompressed layer %s: %w", imageRef, err)
 	}
+	var decodedConfig interface{}
+	decodingErr := yaml.NewYAMLOrJSONDecoder(uncompressed, internal.YamlDecodeBufferSize).Decode(&decodedConfig)
+	if err := verifier.Verify(); err != nil {
+		return nil, fmt.Errorf("verifying layer %s: %w", imageRef, err)
+	}
+	if decodingErr != nil {
+		return nil, fmt.Errorf("yaml blob decoding resulted in an error %s: %w", imageRef, decodingErr)
+	}
 
-	return writeYamlContent(blob, imageRef, configFilePath)
+	return writeYamlContent(decodedConfig, imageRef, configFilePath, imageSpec.Ref)
 }
 
-func pullLayer(ctx context.Context, insecureRegistry bool, imageRef string, keyChain authn.Keychain) (v1.Layer, error) {
+// resolveArtifactDigest resolves the tag of an oci-artifact to the digest of its manifest, so that the artifact
+// is cached by its content and pulled again once the tag is moved.
+func resolveArtifactDigest(ctx context.Context, imageSpec v1beta1.ImageSpec, insecureRegistry bool,
+	keyChain authn.Keychain,
+) (v1beta1.ImageSpec, error) {
+	if imageSpec.Type != v1beta1.OciArtifactType || strings.Contains(imageSpec.Ref, ":") {
+		return imageSpec, nil
+	}
+	imageRef := imageReference(imageSpec)
+	digest, err := crane.Digest(imageRef, craneOptions(ctx, insecureRegistry, keyChain)...)
+	if err != nil {
+		return imageSpec, fmt.Errorf("resolving the digest of artifact %s: %w", imageRef, err)
+	}
+	imageSpec.Ref = digest
+	return imageSpec, nil
+}
+
+// imageReference references the blob of an oci-ref by its digest and an oci-artifact by its tag or digest.
+func imageReference(imageSpec v1beta1.ImageSpec) string {
+	if imageSpec.Type == v1beta1.OciArtifactType && !strings.Contains(imageSpec.Ref, ":") {
+		return fmt.Sprintf("%s/%s:%s", imageSpec.Repo, imageSpec.Name, imageSpec.Ref)
+	}
+	return fmt.Sprintf("%s/%s@%s", imageSpec.Repo, imageSpec.Name, imageSpec.Ref)
+}
+
+// fetchBlob returns the content of the layer of the image spec as it is stored, read from a CTF archive on disk or
+// pulled from its registry, and a reader verifying the content against its digest. Local blobs are verified against
+// the digest of the signed descriptor, and the layers of OCI artifacts against the digest in the artifact manifest.
+func fetchBlob(ctx context.Context, imageSpec v1beta1.ImageSpec, insecureRegistry bool,
+	keyChain authn.Keychain, ctfRoot string,
+) (io.ReadCloser, *digestVerifier, error) {
+	imageRef := imageReference(imageSpec)
+	var blob io.ReadCloser
+	var digest v1.Hash
+	var err error
+	if imageSpec.Type == v1beta1.CTFRefType {
+		if digest, err = v1.NewHash(imageSpec.Ref); err != nil {
+			return nil, nil, fmt.Errorf("parsing digest of layer %s: %w", imageRef, err)
+		}
+		blob, err = openCTFBlob(ctfRoot, imageSpec, digest)
+	} else {
+		blob, digest, err = pullBlob(ctx, insecureRegistry, imageSpec, imageRef, keyChain)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	verifier, err := newDigestVerifier(blob, digest)
+	if err != nil {
+		_ = blob.Close()
+		return nil, nil, fmt.Errorf("verifying layer %s: %w", imageRef, err)
+	}
+	return blob, verifier, nil
+}
+
+func pullBlob(ctx context.Context, insecureRegistry bool, imageSpec v1beta1.ImageSpec, imageRef string,
+	keyChain authn.Keychain,
+) (io.ReadCloser, v1.Hash, error) {
+	layer, err := pullLayer(ctx, insecureRegistry, imageSpec.Type, imageRef, keyChain)
+	if err != nil {
+		return nil, v1.Hash{}, err
+	}
+	var digest v1.Hash
+	if imageSpec.Type == v1beta1.OciArtifactType {
+		digest, err = layer.Digest()
+	} else {
+		digest, err = v1.NewHash(imageSpec.Ref)
+	}
+	if err != nil {
+		return nil, v1.Hash{}, fmt.Errorf("determining digest of layer %s: %w", imageRef, err)
+	}
+	blob, err := layer.Compressed()
+	if err != nil {
+		return nil, v1.Hash{}, fmt.Errorf("fetching blob for layer %s: %w", imageRef, err)
+	}
+	return blob, digest, nil
+}
+
+// decompressIfGzipped decompresses the content if it starts with the gzip magic number.
+func decompressIfGzipped(reader io.Reader) (io.Reader, error) {
+	buffered := bufio.NewReader(reader)
+	if magic, err := buffered.Peek(len(gzipMagic)); err == nil && bytes.Equal(magic, gzipMagic) {
+		return gzip.NewReader(buffered)
+	}
+	return buffered, nil
+}
+
+func pullLayer(ctx context.Context, insecureRegistry bool, refType v1beta1.RefTypeMetadata, imageRef string,
+	keyChain authn.Keychain,
+) (v1.Layer, error) {
+	options := craneOptions(ctx, insecureRegistry, keyChain)
+	if refType == v1beta1.OciArtifactType {
+		return pullArtifactLayer(imageRef, options)
+	}
+	return crane.PullLayer(imageRef, options...)
+}
+
+func craneOptions(ctx context.Context, insecureRegistry bool, keyChain authn.Keychain) []crane.Option {
+	options := []crane.Option{crane.WithAuthFromKeychain(keyChain), crane.WithContext(ctx)}
 	if insecureRegistry {
-		return crane.PullLayer(imageRef, crane.Insecure, crane.WithAuthFromKeychain(keyChain))
+		options = append(options, crane.Insecure)
 	}
-	return crane.PullLayer(imageRef, crane.WithAuthFromKeychain(keyChain), crane.WithContext(ctx))
+	return options
 }
 
-func writeYamlContent(blob io.ReadCloser, layerReference string, filePath string) (interface{}, error) {
-	var decodedConfig interface{}
-	err := yaml.NewYAMLOrJSONDecoder(blob, internal.YamlDecodeBufferSize).Decode(&decodedConfig)
+// pullArtifactLayer pulls the content of an OCI artifact, which is expected in its only layer.
+func pullArtifactLayer(imageRef string, options []crane.Option) (v1.Layer, error) {
+	image, err := crane.Pull(imageRef, options...)
 	if err != nil {
-		return nil, fmt.Errorf("yaml blob decoding resulted in an error %s: %w", layerReference, err)
+		return nil, err
 	}
+	layers, err := image.Layers()
+	if err != nil {
+		return nil, fmt.Errorf("fetching layers of artifact %s: %w", imageRef, err)
+	}
+	if len(layers) != 1 {
+		return nil, fmt.Errorf("%w: %s has %d layers", ErrArtifactLayerAmbiguous, imageRef, len(layers))
+	}
+	return layers[0], nil
+}
 
-	bytes, err := yaml2.Marshal(decodedConfig)
+func writeYamlContent(
+	decodedConfig interface{}, layerReference string, filePath string, ref string,
+) (interface{}, error) {
+	data, err := yaml2.Marshal(decodedConfig)
 	if err != nil {
 		return nil, fmt.Errorf("yaml marshal for install config caused an error %s: %w", layerReference, err)
 	}
 
-	// close file
-	return decodedConfig, internal.WriteToFile(filePath, bytes)
+	// write next to the file path, and move it there once written completely
+	if err := os.MkdirAll(filepath.Dir(filePath), fs.ModePerm); err != nil {
+		return nil, err
+	}
+	file, err := os.CreateTemp(filepath.Dir(filePath), filepath.Base(filePath)+".write-*")
+	if err != nil {
+		return nil, fmt.Errorf("file creation at path %s caused an error: %w", filePath, err)
+	}
+	defer os.Remove(file.Name())
+	if _, err = file.Write(data); err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("writing file to path %s caused an error: %w", filePath, err)
+	}
+	if err := file.Close(); err != nil {
+		return nil, err
+	}
+	return decodedConfig, commitCache(file.Name(), filePath, ref)
 }
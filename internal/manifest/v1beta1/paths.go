@@ -1,8 +1,8 @@
 package v1beta1
 
 import (
-	"fmt"
 	"os"
+	"path"
 	"path/filepath"
 
 	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
@@ -11,10 +11,14 @@ import (
 const (
 	configFileName = "installConfig.yaml"
 	configsFolder  = "configs"
+	chartsFolder   = "charts"
 )
 
+// GetFsChartPath returns the directory the chart of the image spec is extracted to. It is unique for the registry,
+// repository and digest of the layer, so the image spec of an oci-artifact has to be resolved to its digest first.
 func GetFsChartPath(imageSpec v1beta1.ImageSpec) string {
-	return filepath.Join(os.TempDir(), fmt.Sprintf("%s-%s", imageSpec.Name, imageSpec.Ref))
+	repository := path.Clean("/" + path.Join(imageSpec.Repo, imageSpec.Name))
+	return filepath.Join(os.TempDir(), chartsFolder, filepath.FromSlash(repository)+"@"+imageSpec.Ref)
 }
 
 func GetConfigFilePath(config v1beta1.ImageSpec) string {
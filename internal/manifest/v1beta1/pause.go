@@ -0,0 +1,44 @@
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	manifestv1beta1 "github.com/kyma-project/lifecycle-manager/api/v1beta1"
+	declarative "github.com/kyma-project/lifecycle-manager/pkg/declarative/v2"
+	"github.com/kyma-project/lifecycle-manager/pkg/labels"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PauseOnManifestOrKyma pauses the reconciliation of a Manifest while the Manifest itself or the Kyma
+// it belongs to is paused, so that a Pause on a Kyma cascades to all of its Manifests.
+func PauseOnManifestOrKyma(kcp client.Reader) declarative.PauseReconcile {
+	return func(ctx context.Context, obj declarative.Object) (*declarative.Pause, error) {
+		manifest := obj.(*manifestv1beta1.Manifest)
+		now := time.Now()
+		if manifest.Spec.Pause.IsActive(now) {
+			return &declarative.Pause{Message: manifest.Spec.Pause.String(), Until: manifest.Spec.Pause.Expiry()}, nil
+		}
+
+		kymaName, found := manifest.GetLabels()[labels.KymaName]
+		if !found {
+			return nil, nil //nolint:nilnil // a manifest without kyma can only be paused by itself
+		}
+		kyma := &manifestv1beta1.Kyma{}
+		if err := kcp.Get(ctx, client.ObjectKey{Namespace: manifest.GetNamespace(), Name: kymaName}, kyma); err != nil {
+			if k8serrors.IsNotFound(err) {
+				return nil, nil //nolint:nilnil // a manifest without kyma can only be paused by itself
+			}
+			return nil, fmt.Errorf("could not get kyma %s to determine its pause: %w", kymaName, err)
+		}
+		if kyma.Spec.Pause.IsActive(now) {
+			return &declarative.Pause{
+				Message: fmt.Sprintf("kyma %s: %s", kymaName, kyma.Spec.Pause),
+				Until:   kyma.Spec.Pause.Expiry(),
+			}, nil
+		}
+		return nil, nil //nolint:nilnil // neither the manifest nor its kyma is paused
+	}
+}
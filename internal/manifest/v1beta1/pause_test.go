@@ -0,0 +1,63 @@
+package v1beta1_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+	internalv1beta1 "github.com/kyma-project/lifecycle-manager/internal/manifest/v1beta1"
+	"github.com/kyma-project/lifecycle-manager/pkg/labels"
+)
+
+var errKymaUnavailable = errors.New("kyma is unavailable")
+
+type failingReader struct {
+	client.Reader
+}
+
+func (failingReader) Get(context.Context, client.ObjectKey, client.Object, ...client.GetOption) error {
+	return errKymaUnavailable
+}
+
+var _ = Describe("pausing manifests on their kyma", func() {
+	var manifest *v1beta1.Manifest
+	var kcp client.Reader
+
+	BeforeEach(func() {
+		manifest = &v1beta1.Manifest{ObjectMeta: metav1.ObjectMeta{
+			Name: "manifest", Namespace: metav1.NamespaceDefault, Labels: map[string]string{labels.KymaName: "kyma"},
+		}}
+		scheme := runtime.NewScheme()
+		Expect(v1beta1.AddToScheme(scheme)).To(Succeed())
+		kcp = fake.NewClientBuilder().WithScheme(scheme).WithObjects(&v1beta1.Kyma{
+			ObjectMeta: metav1.ObjectMeta{Name: "kyma", Namespace: metav1.NamespaceDefault},
+			Spec:       v1beta1.KymaSpec{Pause: &v1beta1.Pause{Reason: "incident"}},
+		}).Build()
+	})
+
+	It("should pause the manifest while its kyma is paused", func() {
+		pause, err := internalv1beta1.PauseOnManifestOrKyma(kcp)(context.TODO(), manifest)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pause).ToNot(BeNil())
+		Expect(pause.Message).To(ContainSubstring("incident"))
+	})
+
+	It("should not pause the manifest if its kyma does not exist", func() {
+		manifest.Labels[labels.KymaName] = "deleted"
+		pause, err := internalv1beta1.PauseOnManifestOrKyma(kcp)(context.TODO(), manifest)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pause).To(BeNil())
+	})
+
+	It("should fail if the kyma cannot be read", func() {
+		_, err := internalv1beta1.PauseOnManifestOrKyma(failingReader{})(context.TODO(), manifest)
+		Expect(err).To(MatchError(errKymaUnavailable))
+	})
+})
@@ -17,6 +17,8 @@ import (
 	"helm.sh/helm/v3/pkg/getter"
 	"helm.sh/helm/v3/pkg/repo"
 	"helm.sh/helm/v3/pkg/strvals"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -36,15 +38,18 @@ type ManifestSpecResolver struct {
 
 	*v1beta1.Codec
 	Insecure bool
+	// CTFRoot is the directory CTF archives are read from. Installing from CTF archives is disabled if it is empty.
+	CTFRoot string
 
 	ChartCache   string
 	cachedCharts map[string]string
 }
 
-func NewManifestSpecResolver(codec *v1beta1.Codec, insecure bool) *ManifestSpecResolver {
+func NewManifestSpecResolver(codec *v1beta1.Codec, insecure bool, ctfRoot string) *ManifestSpecResolver {
 	return &ManifestSpecResolver{
 		Codec:        codec,
 		Insecure:     insecure,
+		CTFRoot:      ctfRoot,
 		ChartCache:   os.TempDir(),
 		cachedCharts: make(map[string]string),
 	}
@@ -56,6 +61,13 @@ var (
 	ErrNeedUniqueInstall                   = errors.New("can only pass exactly one install")
 )
 
+const (
+	// ConditionTypeLayerIntegrity is False while a pulled or cached layer of the Manifest does not match its digest.
+	ConditionTypeLayerIntegrity            declarative.ConditionType   = "LayerIntegrity"
+	ConditionReasonLayerDigestMismatch     declarative.ConditionReason = "LayerDigestMismatch"
+	ConditionReasonLayerDigestsAreVerified declarative.ConditionReason = "LayerDigestsVerified"
+)
+
 func (m *ManifestSpecResolver) Spec(ctx context.Context, obj declarative.Object) (*declarative.Spec, error) {
 	manifest, ok := obj.(*v1beta1.Manifest)
 	if !ok {
@@ -65,6 +77,36 @@ func (m *ManifestSpecResolver) Spec(ctx context.Context, obj declarative.Object)
 		)
 	}
 
+	spec, err := m.spec(ctx, manifest)
+	updateLayerIntegrityCondition(manifest, err)
+	return spec, err
+}
+
+// updateLayerIntegrityCondition sets the layer integrity condition to False if a layer was refused because its
+// content does not match the digest, and back to True once the layers of the Manifest are verified again.
+func updateLayerIntegrityCondition(manifest *v1beta1.Manifest, err error) {
+	status := manifest.GetStatus()
+	condition := metav1.Condition{
+		Type:               string(ConditionTypeLayerIntegrity),
+		ObservedGeneration: manifest.GetGeneration(),
+	}
+	switch existing := meta.FindStatusCondition(status.Conditions, condition.Type); {
+	case errors.Is(err, ErrLayerDigestMismatch):
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = string(ConditionReasonLayerDigestMismatch)
+		condition.Message = err.Error()
+	case err == nil && existing != nil && existing.Status != metav1.ConditionTrue:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = string(ConditionReasonLayerDigestsAreVerified)
+		condition.Message = "layers match their digests"
+	default:
+		return
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+	manifest.SetStatus(status)
+}
+
+func (m *ManifestSpecResolver) spec(ctx context.Context, manifest *v1beta1.Manifest) (*declarative.Spec, error) {
 	specType, err := v1beta1.GetSpecType(manifest.Spec.Install.Source.Raw)
 	if err != nil {
 		return nil, err
@@ -84,7 +126,7 @@ func (m *ManifestSpecResolver) Spec(ctx context.Context, obj declarative.Object)
 	switch specType {
 	case v1beta1.HelmChartType:
 		mode = declarative.RenderModeHelm
-	case v1beta1.OciRefType:
+	case v1beta1.OciRefType, v1beta1.OciArtifactType, v1beta1.CTFRefType:
 		mode = declarative.RenderModeHelm
 	case v1beta1.KustomizeType:
 		mode = declarative.RenderModeKustomize
@@ -150,7 +192,7 @@ func (m *ManifestSpecResolver) getValuesFromConfig(
 ) (map[string]any, error) {
 	var configs []any
 	if config.Type.NotEmpty() { //nolint:nestif
-		decodedConfig, err := DecodeUncompressedYAMLLayer(ctx, config, m.Insecure, keyChain)
+		decodedConfig, err := DecodeUncompressedYAMLLayer(ctx, config, m.Insecure, keyChain, m.CTFRoot)
 		if err != nil {
 			// if EOF error, we should proceed without config
 			if !errors.Is(err, io.EOF) {
@@ -222,14 +264,14 @@ func (m *ManifestSpecResolver) getChartInfoForInstall(
 			RepoName:  install.Name,
 			URL:       helmChartSpec.URL,
 		}, nil
-	case v1beta1.OciRefType:
+	case v1beta1.OciRefType, v1beta1.OciArtifactType, v1beta1.CTFRefType:
 		var imageSpec v1beta1.ImageSpec
 		if err = m.Codec.Decode(install.Source.Raw, &imageSpec, specType); err != nil {
 			return nil, err
 		}
 
-		// extract helm chart from layer digest
-		chartPath, err := GetPathFromExtractedTarGz(ctx, imageSpec, m.Insecure, keyChain)
+		// extract helm chart from layer digest or from the layer of the artifact
+		chartPath, err := GetPathFromExtractedTarGz(ctx, imageSpec, m.Insecure, keyChain, m.CTFRoot)
 		if err != nil {
 			return nil, err
 		}
@@ -140,7 +140,7 @@ var _ = BeforeSuite(
 		reconciler = declarative.NewFromManager(
 			k8sManager, &v1beta1.Manifest{},
 			declarative.WithSpecResolver(
-				internalv1beta1.NewManifestSpecResolver(codec, true),
+				internalv1beta1.NewManifestSpecResolver(codec, true, ""),
 			),
 			declarative.WithPermanentConsistencyCheck(true),
 			declarative.WithRemoteTargetCluster(
@@ -152,6 +152,7 @@ var _ = BeforeSuite(
 			declarative.WithPostRun{internalv1beta1.PostRunCreateCR},
 			declarative.WithPreDelete{internalv1beta1.PreDeleteDeleteCR},
 			declarative.WithCustomReadyCheck(declarative.NewExistsReadyCheck()),
+			declarative.WithOrphanOn(declarative.OrphanOnAnnotationPresentAndTrue(v1beta1.OrphanResourcesAnnotation)),
 		)
 
 		err = ctrl.NewControllerManagedBy(k8sManager).
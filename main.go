@@ -31,7 +31,9 @@ import (
 	"k8s.io/client-go/util/workqueue"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/kyma-project/lifecycle-manager/pkg/img"
 	"github.com/kyma-project/lifecycle-manager/pkg/istio"
+	"github.com/kyma-project/lifecycle-manager/pkg/module/common"
 	"github.com/kyma-project/lifecycle-manager/pkg/remote"
 	"github.com/kyma-project/lifecycle-manager/pkg/signature"
 	"github.com/kyma-project/lifecycle-manager/pkg/watcher"
@@ -84,6 +86,10 @@ func main() {
 	flagVar := defineFlagVar()
 	flag.Parse()
 	ctrl.SetLogger(log.ConfigLogger(int8(flagVar.logLevel), zapcore.Lock(os.Stdout)))
+	if err := flagVar.validate(); err != nil {
+		setupLog.Error(err, "unable to start manager")
+		os.Exit(1)
+	}
 	if flagVar.pprof {
 		go pprofStartServer(flagVar.pprofAddr, flagVar.pprofServerTimeout)
 	}
@@ -138,12 +144,14 @@ func setupManager(flagVar *FlagVar, newCacheFunc cache.NewCacheFunc, scheme *run
 
 	setupKymaReconciler(mgr, remoteClientCache, flagVar, options)
 	setupManifestReconciler(mgr, flagVar, options)
+	setupModuleRolloutReconciler(mgr, flagVar, options)
+	setupModuleTemplateReconciler(mgr, flagVar, options)
 
 	if flagVar.enableKcpWatcher {
 		setupKcpWatcherReconciler(mgr, options, flagVar)
 	}
 	if flagVar.enableWebhooks {
-		enableWebhooks(mgr)
+		enableWebhooks(mgr, flagVar)
 	}
 
 	//+kubebuilder:scaffold:builder
@@ -161,15 +169,20 @@ func setupManager(flagVar *FlagVar, newCacheFunc cache.NewCacheFunc, scheme *run
 	}
 }
 
-func enableWebhooks(mgr manager.Manager) {
+func enableWebhooks(mgr manager.Manager, flagVar *FlagVar) {
+	verificationSettings := verificationSettingsFromFlagVar(mgr, flagVar)
+	descriptorValidations := []operatorv1beta1.DescriptorValidation{
+		img.ValidateLayers, verificationSettings.VerifyTemplate,
+	}
+
 	if err := (&operatorv1beta1.ModuleTemplate{}).
-		SetupWebhookWithManager(mgr); err != nil {
+		SetupWebhookWithManager(mgr, descriptorValidations...); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "ModuleTemplate")
 		os.Exit(1)
 	}
 
 	if err := (&operatorv1alpha1.ModuleTemplate{}).
-		SetupWebhookWithManager(mgr); err != nil {
+		SetupWebhookWithManager(mgr, descriptorValidations...); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "ModuleTemplate")
 		os.Exit(1)
 	}
@@ -194,6 +207,16 @@ func enableWebhooks(mgr manager.Manager) {
 	}
 }
 
+func verificationSettingsFromFlagVar(mgr manager.Manager, flagVar *FlagVar) signature.VerificationSettings {
+	return signature.VerificationSettings{
+		Client:              mgr.GetClient(),
+		PublicKeyFilePath:   flagVar.moduleVerificationKeyFilePath,
+		CABundleFilePath:    flagVar.moduleVerificationCABundleFilePath,
+		ValidSignatureNames: strings.Split(flagVar.moduleVerificationSignatureNames, ":"),
+		EnableVerification:  flagVar.enableModuleVerification,
+	}
+}
+
 func controllerOptionsFromFlagVar(flagVar *FlagVar) controller.Options {
 	return controller.Options{
 		RateLimiter: workqueue.NewMaxOfRateLimiter(
@@ -258,13 +281,13 @@ func setupKymaReconciler(mgr ctrl.Manager,
 		KcpRestConfig:     kcpRestConfig,
 		RemoteClientCache: remoteClientCache,
 		SKRWebhookManager: skrWebhookManager,
+		DowngradePolicy:   common.DowngradePolicy(flagVar.moduleDowngradePolicy),
+		TemplateResolutionStrategy: operatorv1beta1.TemplateResolutionStrategy(
+			flagVar.templateResolutionStrategy),
 		RequeueIntervals: controllers.RequeueIntervals{
 			Success: flagVar.kymaRequeueSuccessInterval,
 		},
-		VerificationSettings: signature.VerificationSettings{
-			PublicKeyFilePath:   flagVar.moduleVerificationKeyFilePath,
-			ValidSignatureNames: strings.Split(flagVar.moduleVerificationSignatureNames, ":"),
-		},
+		VerificationSettings: verificationSettingsFromFlagVar(mgr, flagVar),
 	}).SetupWithManager(mgr, options, controllers.SetupUpSetting{
 		ListenerAddr:                 flagVar.kymaListenerAddr,
 		EnableDomainNameVerification: flagVar.enableDomainNameVerification,
@@ -283,6 +306,7 @@ func setupManifestReconciler(mgr ctrl.Manager,
 		mgr, options, flagVar.insecureRegistry, flagVar.manifestRequeueSuccessInterval, controllers.SetupUpSetting{
 			ListenerAddr:                 flagVar.manifestListenerAddr,
 			EnableDomainNameVerification: flagVar.enableDomainNameVerification,
+			CTFRootPath:                  flagVar.ctfRootPath,
 		},
 	); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Manifest")
@@ -290,6 +314,36 @@ func setupManifestReconciler(mgr ctrl.Manager,
 	}
 }
 
+func setupModuleRolloutReconciler(mgr ctrl.Manager,
+	flagVar *FlagVar,
+	options controller.Options,
+) {
+	if err := (&controllers.ModuleRolloutReconciler{
+		Client:        mgr.GetClient(),
+		EventRecorder: mgr.GetEventRecorderFor(controllers.ModuleRolloutControllerName),
+		RequeueIntervals: controllers.RequeueIntervals{
+			Success: flagVar.kymaRequeueSuccessInterval,
+		},
+	}).SetupWithManager(mgr, options); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", controllers.ModuleRolloutControllerName)
+		os.Exit(1)
+	}
+}
+
+func setupModuleTemplateReconciler(mgr ctrl.Manager, flagVar *FlagVar, options controller.Options) {
+	if err := (&controllers.ModuleTemplateReconciler{
+		Client:        mgr.GetClient(),
+		EventRecorder: mgr.GetEventRecorderFor(controllers.ModuleTemplateControllerName),
+		RequeueIntervals: controllers.RequeueIntervals{
+			Success: flagVar.kymaRequeueSuccessInterval,
+		},
+		VerificationSettings: verificationSettingsFromFlagVar(mgr, flagVar),
+	}).SetupWithManager(mgr, options); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", controllers.ModuleTemplateControllerName)
+		os.Exit(1)
+	}
+}
+
 func setupKcpWatcherReconciler(mgr ctrl.Manager, options controller.Options, flagVar *FlagVar) {
 	// Set MaxConcurrentReconciles to 1 to avoid concurrent writes on
 	// the Istio virtual service resource the WatcherReconciler is managing.
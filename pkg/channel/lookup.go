@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/go-logr/logr"
@@ -19,23 +21,31 @@ var (
 	ErrTemplateNotIdentified    = errors.New("no unique template could be identified")
 	ErrNotDefaultChannelAllowed = errors.New("specifying no default channel is not allowed")
 	ErrNoTemplatesInListResult  = errors.New("no templates were found during listing")
+	ErrInvalidVersionConstraint = errors.New("module version is not a valid semantic version constraint")
+	ErrNoTemplateMatchesVersion = errors.New("no template matches the desired module version")
+	ErrTemplateRemoved          = errors.New("the module template was removed after its deprecation")
 )
 
 type ModuleTemplate struct {
 	*operatorv1beta1.ModuleTemplate
 	Outdated bool
+	// Resolution describes how the template was chosen if several templates matched the module in its channel.
+	Resolution string
 }
 
 type ModuleTemplatesByModuleName map[string]*ModuleTemplate
 
+// GetTemplates resolves the templates of all modules of the Kyma. The strategy is used for modules
+// with several templates in their channel that do not declare a resolution strategy themselves.
 func GetTemplates(
 	ctx context.Context, client client.Reader, kyma *operatorv1beta1.Kyma,
+	strategy operatorv1beta1.TemplateResolutionStrategy,
 ) (ModuleTemplatesByModuleName, error) {
 	logger := ctrlLog.FromContext(ctx)
 	templates := make(ModuleTemplatesByModuleName)
 
 	for _, module := range kyma.Spec.Modules {
-		template, err := NewTemplateLookup(client, module, kyma.Spec.Channel).WithContext(ctx)
+		template, err := NewTemplateLookup(client, kyma, module, strategy).WithContext(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -109,20 +119,19 @@ func CheckForOutdatedTemplate(
 		}
 
 		checkLog = checkLog.WithValues(
-			"previousVersion", versionInTemplate.String(),
-			"newVersion", versionInStatus.String(),
+			"previousVersion", versionInStatus.String(),
+			"newVersion", versionInTemplate.String(),
 		)
 
 		// channel skews have to be handled with more detail. If a channel is changed this means
 		// that the downstream kyma might have changed its target channel for the module, meaning
 		// the old moduleStatus is reflecting the previous desired state.
 		// when increasing channel stability, this means we could potentially have a downgrade
-		// of module versions here (fast: v2.0.0 get downgraded to regular: v1.0.0). In this
-		// case we want to suspend updating the module until we reach v2.0.0 in regular, since downgrades
-		// are not supported. To circumvent this, a module can be uninstalled and then reinstalled in the old channel.
+		// of module versions here (fast: v2.0.0 get downgraded to regular: v1.0.0). Such downgrades
+		// are not handled here but by the downgrade protection of the module sync, which either blocks
+		// them or applies them if they were explicitly allowed.
 		if versionInStatus.GreaterThan(versionInTemplate) {
-			checkLog.Info("ignore channel skew, as a higher version of the module was previously installed")
-			return
+			checkLog.Info("channel skew would downgrade the module")
 		}
 
 		moduleTemplate.Outdated = true
@@ -133,26 +142,41 @@ type Lookup interface {
 	WithContext(ctx context.Context) (*ModuleTemplate, error)
 }
 
-func NewTemplateLookup(client client.Reader, module operatorv1beta1.Module,
-	defaultChannel string,
+func NewTemplateLookup(client client.Reader, kyma *operatorv1beta1.Kyma, module operatorv1beta1.Module,
+	strategy operatorv1beta1.TemplateResolutionStrategy,
 ) *TemplateLookup {
+	if module.ResolutionStrategy != "" {
+		strategy = module.ResolutionStrategy
+	}
 	return &TemplateLookup{
 		reader:         client,
+		kyma:           kyma,
 		module:         module,
-		defaultChannel: defaultChannel,
+		defaultChannel: kyma.Spec.Channel,
+		strategy:       strategy,
 	}
 }
 
 type TemplateLookup struct {
 	reader         client.Reader
+	kyma           *operatorv1beta1.Kyma
 	module         operatorv1beta1.Module
 	defaultChannel string
+	strategy       operatorv1beta1.TemplateResolutionStrategy
+	// resolution is recorded while looking up the template if it had to be chosen among several candidates.
+	resolution string
 }
 
 func (c *TemplateLookup) WithContext(ctx context.Context) (*ModuleTemplate, error) {
 	desiredChannel := c.getDesiredChannel()
 
-	template, err := c.getTemplate(ctx, desiredChannel)
+	var template *operatorv1beta1.ModuleTemplate
+	var err error
+	if c.module.Version != "" {
+		template, err = c.getTemplateMatchingVersion(ctx, desiredChannel)
+	} else {
+		template, err = c.getTemplate(ctx, desiredChannel)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -167,6 +191,10 @@ func (c *TemplateLookup) WithContext(ctx context.Context) (*ModuleTemplate, erro
 		)
 	}
 
+	if template.Spec.Deprecation.IsRemoved(time.Now()) {
+		return nil, c.newTemplateRemovedErr(template)
+	}
+
 	logger := ctrlLog.FromContext(ctx)
 	if actualChannel != c.defaultChannel {
 		logger.Info(
@@ -187,13 +215,12 @@ func (c *TemplateLookup) WithContext(ctx context.Context) (*ModuleTemplate, erro
 	return &ModuleTemplate{
 		ModuleTemplate: template,
 		Outdated:       false,
+		Resolution:     c.resolution,
 	}, nil
 }
 
-func (c *TemplateLookup) getTemplate(
-	ctx context.Context, desiredChannel string,
-) (*operatorv1beta1.ModuleTemplate, error) {
-	lookupVariants := []client.ListOption{
+func (c *TemplateLookup) getLookupVariants() []client.ListOption {
+	return []client.ListOption{
 		// first try to find a template with "operator.kyma-project.io/module-name" == module.Name
 		operatorv1beta1.ModuleTemplatesByLabel(&c.module),
 		// then try to find a template with FQDN (".spec.descriptor.component.name") == module.Name
@@ -201,6 +228,12 @@ func (c *TemplateLookup) getTemplate(
 		// then try to find a template with "metadata.name" == module.Name
 		index.TemplateNameField.WithValue(c.module.Name),
 	}
+}
+
+func (c *TemplateLookup) getTemplate(
+	ctx context.Context, desiredChannel string,
+) (*operatorv1beta1.ModuleTemplate, error) {
+	lookupVariants := c.getLookupVariants()
 	var template *operatorv1beta1.ModuleTemplate
 	for _, variant := range lookupVariants {
 		var err error
@@ -244,14 +277,231 @@ func (c *TemplateLookup) getModuleTemplateFromDesiredChannel(
 	if err != nil {
 		return nil, err
 	}
-	if len(templateList.Items) > 1 {
-		return nil, NewMoreThanOneTemplateCandidateErr(c.module, templateList.Items, option)
-	}
 	if len(templateList.Items) == 0 {
 		return nil, fmt.Errorf("no templates found with %s in channel %s: %w", option, desiredChannel,
 			ErrNoTemplatesInListResult)
 	}
-	return &templateList.Items[0], nil
+	template, err := c.getTemplateFromRollout(ctx, desiredChannel, templateList.Items)
+	if err != nil || template != nil {
+		return template, err
+	}
+	if len(templateList.Items) == 1 {
+		return &templateList.Items[0], nil
+	}
+	if template = c.getTemplateFromStrategy(ctx, templateList.Items); template == nil {
+		return nil, NewMoreThanOneTemplateCandidateErr(c.module, templateList.Items, option)
+	}
+	return template, nil
+}
+
+// getTemplateFromRollout resolves the templates in the desired channel through a ModuleRollout
+// that stages the module in that channel. Kymas that are part of an already released wave receive the template
+// carrying the version of the rollout, all other Kymas stay on the highest of the remaining versions.
+// If the template of the rollout is the only one in the channel, Kymas stay on the template they
+// currently run as long as it still exists, e.g. after it was moved to another channel.
+// If no ModuleRollout stages the module, no template is returned.
+func (c *TemplateLookup) getTemplateFromRollout(
+	ctx context.Context, desiredChannel string, templates []operatorv1beta1.ModuleTemplate,
+) (*operatorv1beta1.ModuleTemplate, error) {
+	rollout, err := c.getRollout(ctx, desiredChannel)
+	if err != nil || rollout == nil {
+		return nil, err
+	}
+
+	released, err := rollout.Releases(c.kyma)
+	if err != nil {
+		return nil, err
+	}
+
+	var previous []operatorv1beta1.ModuleTemplate
+	var target *operatorv1beta1.ModuleTemplate
+	for i := range templates {
+		descriptor, err := templates[i].Spec.GetUnsafeDescriptor()
+		if err != nil {
+			return nil, fmt.Errorf("could not decode descriptor of template %s: %w", templates[i].GetName(), err)
+		}
+		if descriptor.Version == rollout.Spec.Version {
+			target = &templates[i]
+		} else {
+			previous = append(previous, templates[i])
+		}
+	}
+
+	if target != nil && !released && len(previous) == 0 {
+		if previous, err = c.getInstalledTemplate(ctx, target); err != nil {
+			return nil, err
+		}
+	}
+	if target != nil && (released || len(previous) == 0) {
+		c.resolution = fmt.Sprintf("template %s was released through module rollout %s",
+			target.GetName(), rollout.GetName())
+		return target, nil
+	}
+
+	ctrlLog.FromContext(ctx).V(log.DebugLevel).Info(
+		fmt.Sprintf("module %s is not yet released to kyma through rollout %s", c.module.Name, rollout.GetName()),
+	)
+	template, err := highestTemplateMatchingConstraint(previous, anyVersion(), desiredChannel)
+	if err != nil || template == nil {
+		return template, err
+	}
+	c.resolution = fmt.Sprintf("template %s is used until module rollout %s releases version %s",
+		template.GetName(), rollout.GetName(), rollout.Spec.Version)
+	return template, nil
+}
+
+// getTemplateFromStrategy resolves one of several templates in the desired channel through the resolution strategy.
+// Ties are broken by the name of the templates, so that all Kymas resolve the same template.
+// Templates without a valid version are skipped by the HighestVersion strategy, so that a single broken
+// template does not prevent the resolution of the module.
+// If the strategy is Fail or unknown or no template is valid, no template is returned.
+func (c *TemplateLookup) getTemplateFromStrategy(
+	ctx context.Context, templates []operatorv1beta1.ModuleTemplate,
+) *operatorv1beta1.ModuleTemplate {
+	logger := ctrlLog.FromContext(ctx)
+	var selected *operatorv1beta1.ModuleTemplate
+	switch c.strategy {
+	case operatorv1beta1.TemplateResolutionHighestVersion:
+		var highestVersion *semver.Version
+		for i := range templates {
+			descriptor, err := templates[i].Spec.GetUnsafeDescriptor()
+			if err != nil {
+				logger.Error(err, "skipping template with undecodable descriptor", "template", templates[i].GetName())
+				continue
+			}
+			version, err := semver.NewVersion(descriptor.Version)
+			if err != nil {
+				logger.Error(err, "skipping template with invalid version",
+					"template", templates[i].GetName(), "version", descriptor.Version)
+				continue
+			}
+			if highestVersion == nil || version.GreaterThan(highestVersion) ||
+				(version.Equal(highestVersion) && templates[i].GetName() < selected.GetName()) {
+				selected, highestVersion = &templates[i], version
+			}
+		}
+	case operatorv1beta1.TemplateResolutionNewest:
+		for i := range templates {
+			created := templates[i].GetCreationTimestamp()
+			if selected == nil || selected.CreationTimestamp.Before(&created) ||
+				(created.Equal(&selected.CreationTimestamp) && templates[i].GetName() < selected.GetName()) {
+				selected = &templates[i]
+			}
+		}
+	case operatorv1beta1.TemplateResolutionFail:
+		fallthrough
+	default:
+		return nil // failing on several candidates is up to the caller
+	}
+	if selected == nil {
+		return nil
+	}
+
+	candidates := make([]string, len(templates))
+	for i := range templates {
+		candidates[i] = templates[i].GetName()
+	}
+	sort.Strings(candidates)
+	c.resolution = fmt.Sprintf("template %s was selected from candidates %v with strategy %s",
+		selected.GetName(), candidates, c.strategy)
+	return selected
+}
+
+// getInstalledTemplate returns the template the Kyma currently runs the module with
+// if it is not the given target and still exists.
+func (c *TemplateLookup) getInstalledTemplate(
+	ctx context.Context, target *operatorv1beta1.ModuleTemplate,
+) ([]operatorv1beta1.ModuleTemplate, error) {
+	for _, moduleStatus := range c.kyma.Status.Modules {
+		if moduleStatus.Name != c.module.Name || moduleStatus.Template.GetName() == "" ||
+			moduleStatus.Template.GetName() == target.GetName() {
+			continue
+		}
+		template := &operatorv1beta1.ModuleTemplate{}
+		key := client.ObjectKey{Namespace: moduleStatus.Template.GetNamespace(), Name: moduleStatus.Template.GetName()}
+		if err := c.reader.Get(ctx, key, template); err != nil {
+			return nil, client.IgnoreNotFound(err)
+		}
+		return []operatorv1beta1.ModuleTemplate{*template}, nil
+	}
+	return nil, nil
+}
+
+func (c *TemplateLookup) getRollout(
+	ctx context.Context, desiredChannel string,
+) (*operatorv1beta1.ModuleRollout, error) {
+	rollouts := &operatorv1beta1.ModuleRolloutList{}
+	if err := c.reader.List(ctx, rollouts, client.InNamespace(c.kyma.GetNamespace())); err != nil {
+		return nil, fmt.Errorf("could not list module rollouts: %w", err)
+	}
+	for i := range rollouts.Items {
+		if rollouts.Items[i].Targets(c.module.Name, desiredChannel) {
+			return &rollouts.Items[i], nil
+		}
+	}
+	return nil, nil //nolint:nilnil // a module that is not staged by any rollout is not an error
+}
+
+func anyVersion() *semver.Constraints {
+	constraint, _ := semver.NewConstraint("*")
+	return constraint
+}
+
+// getTemplateMatchingVersion resolves the template with the highest version that satisfies the version constraint
+// of the module, regardless of the channel it is assigned to. If several templates carry the same version,
+// the one in the desired channel is preferred.
+func (c *TemplateLookup) getTemplateMatchingVersion(
+	ctx context.Context, desiredChannel string,
+) (*operatorv1beta1.ModuleTemplate, error) {
+	constraint, err := semver.NewConstraint(c.module.Version)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s for module %s: %s",
+			ErrInvalidVersionConstraint, c.module.Version, c.module.Name, err.Error())
+	}
+
+	lookupVariants := c.getLookupVariants()
+	for _, variant := range lookupVariants {
+		templateList := &operatorv1beta1.ModuleTemplateList{}
+		if err := c.reader.List(ctx, templateList, variant); err != nil {
+			return nil, err
+		}
+		template, err := highestTemplateMatchingConstraint(templateList.Items, constraint, desiredChannel)
+		if err != nil {
+			return nil, err
+		}
+		if template != nil {
+			return template, nil
+		}
+	}
+
+	return nil, fmt.Errorf(
+		"%w: no module template found for module %s with version %s, attempted to lookup via %v",
+		ErrNoTemplateMatchesVersion, c.module.Name, c.module.Version, lookupVariants,
+	)
+}
+
+func highestTemplateMatchingConstraint(
+	templates []operatorv1beta1.ModuleTemplate, constraint *semver.Constraints, desiredChannel string,
+) (*operatorv1beta1.ModuleTemplate, error) {
+	var highestTemplate *operatorv1beta1.ModuleTemplate
+	var highestVersion *semver.Version
+	for i := range templates {
+		template := &templates[i]
+		descriptor, err := template.Spec.GetUnsafeDescriptor()
+		if err != nil {
+			return nil, fmt.Errorf("could not decode descriptor of template %s: %w", template.GetName(), err)
+		}
+		version, err := semver.NewVersion(descriptor.Version)
+		if err != nil || !constraint.Check(version) {
+			continue
+		}
+		if highestVersion == nil || version.GreaterThan(highestVersion) ||
+			(version.Equal(highestVersion) && template.Spec.Channel == desiredChannel) {
+			highestTemplate = template
+			highestVersion = version
+		}
+	}
+	return highestTemplate, nil
 }
 
 func (c *TemplateLookup) getDesiredChannel() string {
@@ -269,6 +519,15 @@ func (c *TemplateLookup) getDesiredChannel() string {
 	return desiredChannel
 }
 
+func (c *TemplateLookup) newTemplateRemovedErr(template *operatorv1beta1.ModuleTemplate) error {
+	version := "unknown"
+	if descriptor, err := template.Spec.GetUnsafeDescriptor(); err == nil {
+		version = descriptor.Version
+	}
+	return fmt.Errorf("%w: %s", ErrTemplateRemoved,
+		template.Spec.Deprecation.RemovalNotice(c.module.Name, version, template.Spec.Channel))
+}
+
 func NewMoreThanOneTemplateCandidateErr(component operatorv1beta1.Module,
 	candidateTemplates []operatorv1beta1.ModuleTemplate, option client.ListOption,
 ) error {
@@ -0,0 +1,33 @@
+package v2
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+//nolint:gochecknoglobals
+var (
+	renderDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name: "lifecycle_mgr_declarative_render_duration_seconds",
+		Help: "Duration of rendering the target resources of an object",
+	})
+	ssaDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name: "lifecycle_mgr_declarative_ssa_duration_seconds",
+		Help: "Duration of the server-side apply of all target resources of an object",
+	})
+	readyCheckDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name: "lifecycle_mgr_declarative_ready_check_duration_seconds",
+		Help: "Duration of the readiness check of all target resources of an object",
+	})
+)
+
+//nolint:gochecknoinits
+func init() {
+	ctrlmetrics.Registry.MustRegister(renderDuration, ssaDuration, readyCheckDuration)
+}
+
+func observeSince(histogram prometheus.Histogram, start time.Time) {
+	histogram.Observe(time.Since(start).Seconds())
+}
@@ -39,10 +39,30 @@ type Status struct {
 	// All resources that are synced are considered for orphan removal on configuration changes,
 	// and it is used to determine effective differences from one state to the next.
 	// +listType=atomic
-	Synced        []Resource `json:"synced,omitempty"`
+	Synced []Resource `json:"synced,omitempty"`
+
+	// Plan contains the changes a reconciliation would apply to the synced resources.
+	// It is only generated while the CustomObject is reconciled in dry-run mode.
+	// +optional
+	Plan *Plan `json:"plan,omitempty"`
+
 	LastOperation `json:"lastOperation,omitempty"`
 }
 
+// Plan describes the changes to resources a reconciliation would apply.
+// +k8s:deepcopy-gen=true
+type Plan struct {
+	// Create contains all resources that do not exist yet and would be created.
+	// +listType=atomic
+	Create []Resource `json:"create,omitempty"`
+	// Update contains all existing resources that would be changed.
+	// +listType=atomic
+	Update []Resource `json:"update,omitempty"`
+	// Prune contains all synced resources that are no longer rendered and would be deleted.
+	// +listType=atomic
+	Prune []Resource `json:"prune,omitempty"`
+}
+
 type State string
 
 // Valid States.
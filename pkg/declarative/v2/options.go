@@ -24,6 +24,8 @@ const (
 	FieldOwnerDefault         = "declarative.kyma-project.io/applier"
 	EventRecorderDefault      = "declarative.kyma-project.io/events"
 	DefaultSkipReconcileLabel = "declarative.kyma-project.io/skip-reconciliation"
+	DefaultDryRunAnnotation   = "declarative.kyma-project.io/dry-run"
+	DefaultOrphanAnnotation   = "declarative.kyma-project.io/orphan-resources"
 	DefaultCacheKey           = "declarative.kyma-project.io/cache-key"
 	DefaultInMemoryParseTTL   = 24 * time.Hour
 )
@@ -45,6 +47,9 @@ func DefaultOptions() *Options {
 		WithClientCacheKeyFromLabelOrResource(DefaultCacheKey),
 		WithManifestCache(os.TempDir()),
 		WithSkipReconcileOn(SkipReconcileOnDefaultLabelPresentAndTrue),
+		WithDryRunOn(DryRunOnAnnotationPresentAndTrue(DefaultDryRunAnnotation)),
+		WithOrphanOn(OrphanOnAnnotationPresentAndTrue(DefaultOrphanAnnotation)),
+		WithPauseOn(NeverPause),
 		WithManifestParser(NewInMemoryCachedManifestParser(DefaultInMemoryParseTTL)),
 	)
 }
@@ -77,7 +82,10 @@ type Options struct {
 
 	DeletePrerequisites bool
 
-	ShouldSkip SkipReconcile
+	ShouldSkip   SkipReconcile
+	ShouldDryRun DryRun
+	ShouldOrphan Orphan
+	ShouldPause  PauseReconcile
 
 	CtrlOnSuccess ctrl.Result
 }
@@ -314,6 +322,92 @@ func (o WithSkipReconcileOnOption) Apply(options *Options) {
 	options.ShouldSkip = o.skipReconcile
 }
 
+func WithDryRunOn(dryRun DryRun) WithDryRunOnOption {
+	return WithDryRunOnOption{dryRun: dryRun}
+}
+
+// DryRun determines if the changes for an Object should only be planned with a server-side dry-run
+// instead of being applied.
+type DryRun func(context.Context, Object) (dryRun bool)
+
+// DryRunOnAnnotationPresentAndTrue determines DryRun by checking if the given annotation is true.
+func DryRunOnAnnotationPresentAndTrue(annotation string) DryRun {
+	return func(ctx context.Context, object Object) bool {
+		if object.GetAnnotations() != nil && object.GetAnnotations()[annotation] == "true" {
+			log.FromContext(ctx, "dry-run-annotation", annotation).
+				V(internal.DebugLogLevel).Info("resource gets planned because of annotation")
+			return true
+		}
+
+		return false
+	}
+}
+
+type WithDryRunOnOption struct {
+	dryRun DryRun
+}
+
+func (o WithDryRunOnOption) Apply(options *Options) {
+	options.ShouldDryRun = o.dryRun
+}
+
+func WithOrphanOn(orphan Orphan) WithOrphanOnOption {
+	return WithOrphanOnOption{orphan: orphan}
+}
+
+// Orphan determines if the resources of an Object should be left behind in the cluster
+// instead of being cleaned up when the Object is deleted.
+type Orphan func(context.Context, Object) (orphan bool)
+
+// OrphanOnAnnotationPresentAndTrue determines Orphan by checking if the given annotation is true.
+func OrphanOnAnnotationPresentAndTrue(annotation string) Orphan {
+	return func(ctx context.Context, object Object) bool {
+		if object.GetAnnotations() != nil && object.GetAnnotations()[annotation] == "true" {
+			log.FromContext(ctx, "orphan-annotation", annotation).
+				V(internal.DebugLogLevel).Info("resources get orphaned because of annotation")
+			return true
+		}
+
+		return false
+	}
+}
+
+type WithOrphanOnOption struct {
+	orphan Orphan
+}
+
+func (o WithOrphanOnOption) Apply(options *Options) {
+	options.ShouldOrphan = o.orphan
+}
+
+func WithPauseOn(pause PauseReconcile) WithPauseOnOption {
+	return WithPauseOnOption{pause: pause}
+}
+
+// Pause describes why the reconciliation of an Object is paused and when the pause expires.
+// A zero Until means that the pause does not expire.
+type Pause struct {
+	Message string
+	Until   time.Time
+}
+
+// PauseReconcile determines if the reconciliation of an Object is paused. It returns nil if it is not paused.
+// Objects that are deleted are never paused.
+type PauseReconcile func(context.Context, Object) (*Pause, error)
+
+// NeverPause is a PauseReconcile that never pauses the reconciliation.
+func NeverPause(context.Context, Object) (*Pause, error) {
+	return nil, nil //nolint:nilnil // an object that is not paused is not an error
+}
+
+type WithPauseOnOption struct {
+	pause PauseReconcile
+}
+
+func (o WithPauseOnOption) Apply(options *Options) {
+	options.ShouldPause = o.pause
+}
+
 type ClientCacheKeyFn func(ctx context.Context, obj Object) any
 
 func WithClientCacheKeyFromLabelOrResource(label string) WithClientCacheKeyOption {
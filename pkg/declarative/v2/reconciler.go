@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"helm.sh/helm/v3/pkg/kube"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/cli-runtime/pkg/resource"
@@ -41,6 +43,7 @@ type ConditionType string
 const (
 	ConditionTypeResources    ConditionType = "Resources"
 	ConditionTypeInstallation ConditionType = "Installation"
+	ConditionTypePaused       ConditionType = "Paused"
 )
 
 type ConditionReason string
@@ -48,6 +51,7 @@ type ConditionReason string
 const (
 	ConditionReasonResourcesAreAvailable ConditionReason = "ResourcesAvailable"
 	ConditionReasonReady                 ConditionReason = "Ready"
+	ConditionReasonReconciliationPaused  ConditionReason = "ReconciliationPaused"
 )
 
 func newInstallationCondition(obj Object) metav1.Condition {
@@ -82,6 +86,20 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, nil
 	}
 
+	// a pause never holds back the deletion of the object
+	if obj.GetDeletionTimestamp().IsZero() {
+		pause, err := r.ShouldPause(ctx, obj)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("could not determine pause: %w", err)
+		}
+		if pause != nil {
+			return r.pause(ctx, obj, pause)
+		}
+	}
+	if r.resume(obj) {
+		return r.ssaStatus(ctx, obj)
+	}
+
 	if err := r.initialize(obj); err != nil {
 		return r.ssaStatus(ctx, obj)
 	}
@@ -91,6 +109,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		if controllerutil.AddFinalizer(objMeta, r.Finalizer) {
 			return r.ssa(ctx, objMeta)
 		}
+	} else if r.ShouldOrphan(ctx, obj) {
+		return r.orphan(ctx, obj)
 	}
 
 	spec, err := r.Spec(ctx, obj)
@@ -118,6 +138,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	}
 
 	diff := kube.ResourceList(current).Difference(target)
+	if obj.GetDeletionTimestamp().IsZero() && r.ShouldDryRun(ctx, obj) {
+		return r.plan(ctx, clnt, obj, target, diff)
+	}
+	if status := obj.GetStatus(); status.Plan != nil {
+		status.Plan = nil
+		obj.SetStatus(status)
+		return r.ssaStatus(ctx, obj)
+	}
+
 	if err := r.pruneDiff(ctx, clnt, obj, renderer, diff); errors.Is(err, ErrDeletionNotFinished) {
 		return ctrl.Result{Requeue: true}, nil
 	} else if err != nil {
@@ -141,6 +170,60 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	return r.CtrlOnSuccess, nil
 }
 
+// pause only reflects the Pause in the Paused condition and requeues the Object once the Pause expires.
+func (r *Reconciler) pause(ctx context.Context, obj Object, pause *Pause) (ctrl.Result, error) {
+	result := r.CtrlOnSuccess
+	if !pause.Until.IsZero() && (result.RequeueAfter == 0 || time.Until(pause.Until) < result.RequeueAfter) {
+		result = ctrl.Result{RequeueAfter: time.Until(pause.Until)}
+	}
+
+	status := obj.GetStatus()
+	if condition := meta.FindStatusCondition(status.Conditions, string(ConditionTypePaused)); condition != nil &&
+		condition.Status == metav1.ConditionTrue && condition.Message == pause.Message {
+		return result, nil
+	}
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               string(ConditionTypePaused),
+		Reason:             string(ConditionReasonReconciliationPaused),
+		Status:             metav1.ConditionTrue,
+		Message:            pause.Message,
+		ObservedGeneration: obj.GetGeneration(),
+	})
+	obj.SetStatus(status.WithOperation(pause.Message))
+	r.Event(obj, "Normal", "Paused", pause.Message)
+	_, err := r.ssaStatus(ctx, obj)
+	return result, err
+}
+
+// resume turns the Paused condition False once the reconciliation is no longer paused.
+// It returns true if the condition was changed.
+func (r *Reconciler) resume(obj Object) bool {
+	status := obj.GetStatus()
+	condition := meta.FindStatusCondition(status.Conditions, string(ConditionTypePaused))
+	if condition == nil || condition.Status == metav1.ConditionFalse {
+		return false
+	}
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               string(ConditionTypePaused),
+		Reason:             string(ConditionReasonReconciliationPaused),
+		Status:             metav1.ConditionFalse,
+		Message:            "reconciliation is resumed",
+		ObservedGeneration: obj.GetGeneration(),
+	})
+	obj.SetStatus(status.WithOperation("reconciliation is resumed"))
+	return true
+}
+
+// orphan removes the finalizer of an Object that is deleted without cleaning up its resources
+// in the target cluster.
+func (r *Reconciler) orphan(ctx context.Context, obj Object) (ctrl.Result, error) {
+	if controllerutil.RemoveFinalizer(obj, r.Finalizer) {
+		r.Event(obj, "Normal", "Orphan", "resources are left behind in the target cluster")
+		return ctrl.Result{}, r.Update(ctx, obj) // no SSA since delete does not work for finalizers.
+	}
+	return ctrl.Result{}, nil
+}
+
 func (r *Reconciler) partialObjectMetadata(obj Object) *metav1.PartialObjectMetadata {
 	objMeta := &metav1.PartialObjectMetadata{}
 	objMeta.SetName(obj.GetName())
@@ -220,12 +303,56 @@ func (r *Reconciler) renderResources(
 	return target, current, nil
 }
 
+// plan determines the changes a reconciliation would apply with a server-side dry-run and writes them
+// into the status instead of applying them. Prerequisites of the renderer are still ensured, as a dry-run
+// of custom resources requires their definitions to be present.
+func (r *Reconciler) plan(
+	ctx context.Context, clnt Client, obj Object, target, prune []*resource.Info,
+) (ctrl.Result, error) {
+	status := obj.GetStatus()
+
+	created, updated, err := ConcurrentSSA(clnt, r.FieldOwner).DryRun(ctx, target)
+	if err != nil {
+		r.Event(obj, "Warning", "ServerSideApplyDryRun", err.Error())
+		obj.SetStatus(status.WithState(StateError).WithErr(err))
+		return r.ssaStatus(ctx, obj)
+	}
+
+	plan := &Plan{
+		Create: plannedResources(created),
+		Update: plannedResources(updated),
+		Prune:  plannedResources(prune),
+	}
+	if equality.Semantic.DeepEqual(status.Plan, plan) {
+		return r.CtrlOnSuccess, nil
+	}
+
+	const msg = "dry-run plan generated"
+	r.Event(obj, "Normal", "DryRun", msg)
+	status.Plan = plan
+	obj.SetStatus(status.WithOperation(msg))
+	if _, err := r.ssaStatus(ctx, obj); err != nil {
+		return ctrl.Result{}, err
+	}
+	return r.CtrlOnSuccess, nil
+}
+
+func plannedResources(infos []*resource.Info) []Resource {
+	if len(infos) == 0 {
+		return nil
+	}
+	return NewInfoToResourceConverter().InfosToResources(infos)
+}
+
 func (r *Reconciler) syncResources(
 	ctx context.Context, clnt Client, obj Object, target []*resource.Info,
 ) error {
 	status := obj.GetStatus()
 
-	if err := ConcurrentSSA(clnt, r.FieldOwner).Run(ctx, target); err != nil {
+	ssaStart := time.Now()
+	err := ConcurrentSSA(clnt, r.FieldOwner).Run(ctx, target)
+	observeSince(ssaDuration, ssaStart)
+	if err != nil {
 		r.Event(obj, "Warning", "ServerSideApply", err.Error())
 		obj.SetStatus(status.WithState(StateError).WithErr(err))
 		return err
@@ -261,7 +388,9 @@ func (r *Reconciler) checkTargetReadiness(
 		resourceReadyCheck = NewHelmReadyCheck(clnt)
 	}
 
+	readyCheckStart := time.Now()
 	err := resourceReadyCheck.Run(ctx, clnt, obj, target)
+	observeSince(readyCheckDuration, readyCheckStart)
 
 	if errors.Is(err, ErrResourcesNotReady) || errors.Is(err, ErrCustomResourceStateNotFound) {
 		waitingMsg := fmt.Sprintf("waiting for resources to become ready: %s", err.Error())
@@ -328,7 +457,9 @@ func (r *Reconciler) renderTargetResources(
 
 	status := obj.GetStatus()
 
+	renderStart := time.Now()
 	targetResources, err := r.ManifestParser.Parse(ctx, renderer, obj, spec)
+	observeSince(renderDuration, renderStart)
 	if err != nil {
 		r.Event(obj, "Warning", "ManifestParsing", err.Error())
 		obj.SetStatus(status.WithState(StateError).WithErr(err))
@@ -4,12 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/kyma-project/lifecycle-manager/internal"
 	"github.com/kyma-project/lifecycle-manager/pkg/types"
 
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -128,3 +132,83 @@ func (c *ConcurrentDefaultSSA) convertUnstructuredToTyped(
 	}
 	return obj
 }
+
+// DryRun server-side applies the resources with dryRun=All and determines which of them would be created
+// and which existing ones would be changed. Nothing is persisted in the cluster.
+func (c *ConcurrentDefaultSSA) DryRun(
+	ctx context.Context, resources []*resource.Info,
+) ([]*resource.Info, []*resource.Info, error) {
+	logger := log.FromContext(ctx, "owner", c.owner)
+	logger.V(internal.TraceLogLevel).Info("ServerSideApply DryRun", "resources", len(resources))
+
+	exists := make([]bool, len(resources))
+	changed := make([]bool, len(resources))
+	errs := make([]error, len(resources))
+
+	var wg sync.WaitGroup
+	for i := range resources {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			exists[i], changed[i], errs[i] = c.serverSideApplyDryRun(ctx, resources[i])
+		}()
+	}
+	wg.Wait()
+
+	var created, updated []*resource.Info
+	var dryRunErrs []error
+	for i := range resources {
+		switch {
+		case errs[i] != nil:
+			dryRunErrs = append(dryRunErrs, errs[i])
+		case !exists[i]:
+			created = append(created, resources[i])
+		case changed[i]:
+			updated = append(updated, resources[i])
+		}
+	}
+
+	if dryRunErrs != nil {
+		return nil, nil, fmt.Errorf("ServerSideApply DryRun failed: %w", types.NewMultiError(dryRunErrs))
+	}
+
+	return created, updated, nil
+}
+
+// serverSideApplyDryRun determines if the resource exists and if a server-side apply would change it.
+func (c *ConcurrentDefaultSSA) serverSideApplyDryRun(
+	ctx context.Context, info *resource.Info,
+) (bool, bool, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(info.Object)
+	if err != nil {
+		return false, false, fmt.Errorf("%s could not be converted: %w", info.ObjectName(), err)
+	}
+	applied := &unstructured.Unstructured{Object: content}
+
+	live := &unstructured.Unstructured{}
+	live.SetGroupVersionKind(applied.GroupVersionKind())
+	exists := true
+	if err := c.clnt.Get(ctx, client.ObjectKeyFromObject(applied), live); k8serrors.IsNotFound(err) {
+		exists = false
+	} else if err != nil {
+		return false, false, fmt.Errorf("get for %s failed: %w", info.ObjectName(), err)
+	}
+
+	err = c.clnt.Patch(ctx, applied, client.Apply, client.ForceOwnership, c.owner, client.DryRunAll)
+	if err != nil {
+		return exists, false, fmt.Errorf("dry-run patch for %s failed: %w", info.ObjectName(), err)
+	}
+
+	changed := exists && !equality.Semantic.DeepEqual(withoutVolatileFields(live), withoutVolatileFields(applied))
+	return exists, changed, nil
+}
+
+// withoutVolatileFields removes all fields that change on every apply even if the resource itself does not change.
+func withoutVolatileFields(obj *unstructured.Unstructured) map[string]any {
+	content := obj.DeepCopy().Object
+	unstructured.RemoveNestedField(content, "metadata", "managedFields")
+	unstructured.RemoveNestedField(content, "metadata", "resourceVersion")
+	unstructured.RemoveNestedField(content, "metadata", "generation")
+	return content
+}
@@ -3,6 +3,7 @@ package img
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -10,7 +11,11 @@ import (
 type LayerName string
 
 const (
-	OCIRepresentationType  = "oci-ref"
+	OCIRepresentationType = "oci-ref"
+	// OCIArtifactRepresentationType refers to a whole OCI artifact by tag or digest instead of a single blob.
+	OCIArtifactRepresentationType = "oci-artifact"
+	// CTFRepresentationType refers to a blob in a CTF archive on the filesystem by its digest.
+	CTFRepresentationType  = "ctf-ref"
 	HelmRepresentationType = "helm-chart"
 )
 
@@ -36,6 +41,9 @@ func (o *OCI) ToInstallRaw() ([]byte, error) {
 }
 
 func (o *OCI) String() string {
+	if strings.Contains(o.Ref, ":") {
+		return fmt.Sprintf("%s/%s@%s", o.Repo, o.Name, o.Ref)
+	}
 	return fmt.Sprintf("%s/%s:%s", o.Repo, o.Name, o.Ref)
 }
 
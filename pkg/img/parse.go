@@ -1,12 +1,15 @@
 package img
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"path"
 
+	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/kyma-project/lifecycle-manager/pkg/ocmextensions"
 
 	ocm "github.com/gardener/component-spec/bindings-go/apis/v2"
@@ -20,32 +23,71 @@ var (
 	ErrAccessTypeNotSupported           = errors.New("access type not supported")
 	ErrContextTypeNotSupported          = errors.New("context type not supported")
 	ErrComponentNameMappingNotSupported = errors.New("componentNameMapping not supported")
+	ErrInstallLayerMissing              = errors.New("no layer found that can be installed")
+	ErrDuplicateLayer                   = errors.New("layer is defined more than once")
+	ErrInvalidImageReference            = errors.New("image reference of the access is invalid")
+	ErrContextMissing                   = errors.New("no repository context found to resolve local blobs")
+	ErrDigestMissing                    = errors.New("local blob does not have a digest")
 )
 
 func Parse(
 	descriptor *ocm.ComponentDescriptor,
 ) (Layers, error) {
-	ctx := descriptor.GetEffectiveRepositoryContext()
-	if ctx == nil {
-		return Layers{}, nil
+	var repo *repositoryContext
+	if ctx := descriptor.GetEffectiveRepositoryContext(); ctx != nil {
+		var err error
+		if repo, err = parseRepositoryContext(ctx); err != nil {
+			return nil, err
+		}
 	}
-	return parseDescriptor(ctx, descriptor)
+	return parseLayersByName(repo, descriptor)
 }
 
-func parseDescriptor(ctx *ocm.UnstructuredTypedObject, descriptor *ocm.ComponentDescriptor) (Layers, error) {
+// repositoryContext is the repository local blobs are resolved in, either an OCI registry or a CTF archive.
+type repositoryContext struct {
+	oci *ocm.OCIRegistryRepository
+	ctf *ocmextensions.CTFRepository
+}
+
+// ValidateLayers is a v1beta1.DescriptorValidation that ensures the layers of the descriptor can be parsed
+// and that at least one of them is an install layer, i.e. neither the config nor the crds layer.
+// Every layer may only be defined once, as only one of them would be installed otherwise.
+func ValidateLayers(_ context.Context, _ *v1beta1.ModuleTemplate, descriptor *ocm.ComponentDescriptor) error {
+	layers, err := Parse(descriptor)
+	if err != nil {
+		return err
+	}
+	names := make(map[LayerName]bool, len(layers))
+	hasInstallLayer := false
+	for _, layer := range layers {
+		if names[layer.LayerName] {
+			return fmt.Errorf("%w: %s in descriptor %s", ErrDuplicateLayer, layer.LayerName, descriptor.GetName())
+		}
+		names[layer.LayerName] = true
+		if layer.LayerName != ConfigLayer && layer.LayerName != CRDsLayer {
+			hasInstallLayer = true
+		}
+	}
+	if !hasInstallLayer {
+		return fmt.Errorf("%w: descriptor %s", ErrInstallLayerMissing, descriptor.GetName())
+	}
+	return nil
+}
+
+func parseRepositoryContext(ctx *ocm.UnstructuredTypedObject) (*repositoryContext, error) {
 	switch ctx.GetType() {
 	case ocm.OCIRegistryType:
 		repo := &ocm.OCIRegistryRepository{}
 		if err := ctx.DecodeInto(repo); err != nil {
 			return nil, fmt.Errorf("error while decoding the repository context into an OCI registry: %w", err)
 		}
-
-		layersByName, err := parseLayersByName(repo, descriptor)
-		if err != nil {
-			return nil, err
+		return &repositoryContext{oci: repo}, nil
+	case ocmextensions.CTFRepositoryType, ocmextensions.CTFRepositoryTypeVersioned:
+		repo := &ocmextensions.CTFRepository{}
+		if err := ctx.DecodeInto(repo); err != nil {
+			return nil, fmt.Errorf("error while decoding the repository context into a CTF archive: %w", err)
 		}
-
-		return layersByName, nil
+		return &repositoryContext{ctf: repo}, nil
 	default:
 		return nil, fmt.Errorf("error while parsing context type %s: %w",
 			ctx.GetType(), ErrContextTypeNotSupported,
@@ -53,13 +95,18 @@ func parseDescriptor(ctx *ocm.UnstructuredTypedObject, descriptor *ocm.Component
 	}
 }
 
-func parseLayersByName(repo *ocm.OCIRegistryRepository, descriptor *ocm.ComponentDescriptor) (Layers, error) {
+// parseLayersByName translates the resources of the descriptor into layers. Local blobs are resolved
+// in the repository context of the descriptor, while OCI artifacts carry their full reference.
+func parseLayersByName(repo *repositoryContext, descriptor *ocm.ComponentDescriptor) (Layers, error) {
 	layers := Layers{}
 	for _, resource := range descriptor.Resources {
 		access := resource.Access
 		var layerRepresentation LayerRepresentation
 		switch access.GetType() {
 		case ocm.LocalOCIBlobType:
+			if repo == nil {
+				return nil, fmt.Errorf("resolving local blob of resource %s: %w", resource.Name, ErrContextMissing)
+			}
 			ociAccess := &ocm.LocalOCIBlobAccess{}
 			if err := access.DecodeInto(ociAccess); err != nil {
 				return nil, fmt.Errorf("error while decoding the access into OCIRegistryRepository: %w", err)
@@ -70,6 +117,17 @@ func parseLayersByName(repo *ocm.OCIRegistryRepository, descriptor *ocm.Componen
 				return nil, fmt.Errorf("building the digest url: %w", err)
 			}
 			layerRepresentation = layerRef
+		case ocm.OCIRegistryType, ocmextensions.OCIArtifactType:
+			artifactAccess := &ocmextensions.OCIArtifactAccess{}
+			if err := access.DecodeInto(artifactAccess); err != nil {
+				return nil, fmt.Errorf("error while decoding the access into OCIArtifactAccess: %w", err)
+			}
+
+			artifactRef, err := getOCIArtifactRef(artifactAccess.ImageReference, resource.Labels)
+			if err != nil {
+				return nil, fmt.Errorf("building the artifact reference: %w", err)
+			}
+			layerRepresentation = artifactRef
 		case ocmextensions.HelmChartRepositoryType:
 			helmChartAccess := &ocmextensions.HelmChartRepositoryAccess{}
 			if err := access.DecodeInto(helmChartAccess); err != nil {
@@ -95,11 +153,15 @@ func parseLayersByName(repo *ocm.OCIRegistryRepository, descriptor *ocm.Componen
 	return layers, nil
 }
 
-func getOCIRef(repo *ocm.OCIRegistryRepository,
+func getOCIRef(repo *repositoryContext,
 	descriptor *ocm.ComponentDescriptor,
 	ref string,
 	labels ocm.Labels,
 ) (*OCI, error) {
+	if repo.ctf != nil {
+		return getCTFRef(repo.ctf, descriptor, ref)
+	}
+
 	layerRef := OCI{
 		Type: OCIRepresentationType,
 	}
@@ -111,36 +173,79 @@ func getOCIRef(repo *ocm.OCIRegistryRepository,
 	} else {
 		layerRef.Ref = ref
 	}
-	if registryCredValue, found := labels.Get(v1beta1.OCIRegistryCredLabel); found {
-		credSecretLabel := make(map[string]string)
-		if err := json.Unmarshal(registryCredValue, &credSecretLabel); err != nil {
-			return nil, err
-		}
-		layerRef.CredSecretSelector = &metav1.LabelSelector{
-			MatchLabels: credSecretLabel,
-		}
+	credSecretSelector, err := getCredSecretSelector(labels)
+	if err != nil {
+		return nil, err
 	}
+	layerRef.CredSecretSelector = credSecretSelector
 
-	switch repo.ComponentNameMapping {
+	switch repo.oci.ComponentNameMapping {
 	case ocm.OCIRegistryURLPathMapping:
 		repoSubpath := DefaultRepoSubdirectory
 		if ext, found := descriptor.GetLabels().Get(
 			fmt.Sprintf("%s%s", ocm.OCIRegistryURLPathMapping, "RepoSubpath")); found {
 			repoSubpath = string(ext)
 		}
-		layerRef.Repo = fmt.Sprintf("%s/%s", repo.BaseURL, repoSubpath)
+		layerRef.Repo = fmt.Sprintf("%s/%s", repo.oci.BaseURL, repoSubpath)
 		layerRef.Name = descriptor.GetName()
 	case ocm.OCIRegistryDigestMapping:
-		layerRef.Repo = repo.BaseURL
+		layerRef.Repo = repo.oci.BaseURL
 		layerRef.Name = sha256sum(descriptor.GetName())
 	default:
 		return nil, fmt.Errorf("error while parsing componentNameMapping %s: %w",
-			repo.ComponentNameMapping, ErrComponentNameMappingNotSupported,
+			repo.oci.ComponentNameMapping, ErrComponentNameMappingNotSupported,
 		)
 	}
 	return &layerRef, nil
 }
 
+// getCTFRef references a local blob in a CTF archive by its digest. The archive is read from disk
+// by the Manifest reconciler, so no registry credentials are needed.
+func getCTFRef(repo *ocmextensions.CTFRepository, descriptor *ocm.ComponentDescriptor, ref string) (*OCI, error) {
+	if ref == "" {
+		return nil, fmt.Errorf("%w: local blob in %s", ErrDigestMissing, repo.FilePath)
+	}
+	return &OCI{
+		Repo: repo.FilePath,
+		Name: descriptor.GetName(),
+		Ref:  ref,
+		Type: CTFRepresentationType,
+	}, nil
+}
+
+// getOCIArtifactRef splits a global image reference into repository, name and its tag or digest.
+// Contrary to local blobs, the reference does not depend on the repository context of the descriptor.
+func getOCIArtifactRef(imageReference string, labels ocm.Labels) (*OCI, error) {
+	ref, err := name.ParseReference(imageReference)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidImageReference, err.Error())
+	}
+	repository := ref.Context().RepositoryStr()
+	credSecretSelector, err := getCredSecretSelector(labels)
+	if err != nil {
+		return nil, err
+	}
+	return &OCI{
+		Repo:               path.Join(ref.Context().RegistryStr(), path.Dir(repository)),
+		Name:               path.Base(repository),
+		Ref:                ref.Identifier(),
+		Type:               OCIArtifactRepresentationType,
+		CredSecretSelector: credSecretSelector,
+	}, nil
+}
+
+func getCredSecretSelector(labels ocm.Labels) (*metav1.LabelSelector, error) {
+	registryCredValue, found := labels.Get(v1beta1.OCIRegistryCredLabel)
+	if !found {
+		return nil, nil //nolint:nilnil // credentials are optional
+	}
+	credSecretLabel := make(map[string]string)
+	if err := json.Unmarshal(registryCredValue, &credSecretLabel); err != nil {
+		return nil, err
+	}
+	return &metav1.LabelSelector{MatchLabels: credSecretLabel}, nil
+}
+
 func sha256sum(s string) string {
 	sum := sha256.Sum256([]byte(s))
 	return hex.EncodeToString(sum[:])
@@ -0,0 +1,144 @@
+package img_test
+
+import (
+	"context"
+	"testing"
+
+	ocm "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/lifecycle-manager/pkg/img"
+)
+
+func descriptorWithAccess(access *ocm.UnstructuredTypedObject) *ocm.ComponentDescriptor {
+	return &ocm.ComponentDescriptor{ComponentSpec: ocm.ComponentSpec{
+		ObjectMeta: ocm.ObjectMeta{Name: "kyma-project.io/module/sample", Version: "1.0.0"},
+		Resources: []ocm.Resource{{
+			IdentityObjectMeta: ocm.IdentityObjectMeta{Name: "sample-operator", Type: "helm-chart"},
+			Access:             access,
+		}},
+	}}
+}
+
+//nolint:funlen
+func TestParse_GlobalAccess(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		accessType   string
+		reference    string
+		expectedRepo string
+		expectedName string
+		expectedRef  string
+		expectedOCI  string
+	}{
+		{
+			"tagged oci artifact",
+			"ociArtifact",
+			"europe-docker.pkg.dev/kyma/charts/sample:1.0.0",
+			"europe-docker.pkg.dev/kyma/charts",
+			"sample",
+			"1.0.0",
+			"europe-docker.pkg.dev/kyma/charts/sample:1.0.0",
+		},
+		{
+			"digest-pinned oci registry access",
+			ocm.OCIRegistryType,
+			"ghcr.io/kyma/sample@sha256:4d1293833dcc851452895441d123fc73aa0ea3870bd1b316c16f59644183eea9",
+			"ghcr.io/kyma",
+			"sample",
+			"sha256:4d1293833dcc851452895441d123fc73aa0ea3870bd1b316c16f59644183eea9",
+			"ghcr.io/kyma/sample@sha256:4d1293833dcc851452895441d123fc73aa0ea3870bd1b316c16f59644183eea9",
+		},
+	}
+	for _, testCase := range tests {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			access := ocm.NewUnstructuredType(testCase.accessType, map[string]interface{}{
+				"imageReference": testCase.reference,
+			})
+			layers, err := img.Parse(descriptorWithAccess(access))
+			require.NoError(t, err)
+			require.Len(t, layers, 1)
+
+			oci, ok := layers[0].LayerRepresentation.(*img.OCI)
+			require.True(t, ok)
+			assert.Equal(t, testCase.expectedRepo, oci.Repo)
+			assert.Equal(t, testCase.expectedName, oci.Name)
+			assert.Equal(t, testCase.expectedRef, oci.Ref)
+			assert.Equal(t, img.OCIArtifactRepresentationType, oci.Type)
+			assert.Equal(t, testCase.expectedOCI, oci.String())
+		})
+	}
+}
+
+func TestParse_LocalBlobWithoutContext(t *testing.T) {
+	t.Parallel()
+	access := ocm.NewUnstructuredType(ocm.LocalOCIBlobType, map[string]interface{}{"digest": "sha256:abc"})
+	_, err := img.Parse(descriptorWithAccess(access))
+	assert.ErrorIs(t, err, img.ErrContextMissing)
+}
+
+func TestParse_CTFContext(t *testing.T) {
+	t.Parallel()
+	const digest = "sha256:4d1293833dcc851452895441d123fc73aa0ea3870bd1b316c16f59644183eea9"
+	access := ocm.NewUnstructuredType(ocm.LocalOCIBlobType, map[string]interface{}{"digest": digest})
+	descriptor := descriptorWithAccess(access)
+	ctx := ocm.NewUnstructuredType("CommonTransportFormat/v1", map[string]interface{}{
+		"filePath": "modules/sample.ctf", "fileFormat": "tgz",
+	})
+	descriptor.RepositoryContexts = append(descriptor.RepositoryContexts, ctx)
+
+	layers, err := img.Parse(descriptor)
+	require.NoError(t, err)
+	require.Len(t, layers, 1)
+
+	oci, ok := layers[0].LayerRepresentation.(*img.OCI)
+	require.True(t, ok)
+	assert.Equal(t, "modules/sample.ctf", oci.Repo)
+	assert.Equal(t, "kyma-project.io/module/sample", oci.Name)
+	assert.Equal(t, digest, oci.Ref)
+	assert.Equal(t, img.CTFRepresentationType, oci.Type)
+}
+
+func TestValidateLayers(t *testing.T) {
+	t.Parallel()
+	artifact := func(name string) ocm.Resource {
+		return ocm.Resource{
+			IdentityObjectMeta: ocm.IdentityObjectMeta{Name: name, Type: "helm-chart"},
+			Access: ocm.NewUnstructuredType("ociArtifact", map[string]interface{}{
+				"imageReference": "europe-docker.pkg.dev/kyma/charts/" + name + ":1.0.0",
+			}),
+		}
+	}
+	tests := []struct {
+		name      string
+		resources []ocm.Resource
+		expected  error
+	}{
+		{"install layer", []ocm.Resource{artifact("config"), artifact("sample-operator")}, nil},
+		{"missing install layer", []ocm.Resource{artifact("config"), artifact("crds")}, img.ErrInstallLayerMissing},
+		{"no layer", nil, img.ErrInstallLayerMissing},
+		{"duplicate layer", []ocm.Resource{artifact("sample-operator"), artifact("sample-operator")}, img.ErrDuplicateLayer},
+		{"unknown layer type", []ocm.Resource{{
+			IdentityObjectMeta: ocm.IdentityObjectMeta{Name: "sample-operator", Type: "helm-chart"},
+			Access:             ocm.NewUnstructuredType("s3", map[string]interface{}{"bucketName": "charts"}),
+		}}, img.ErrAccessTypeNotSupported},
+	}
+	for _, testCase := range tests {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			descriptor := descriptorWithAccess(nil)
+			descriptor.Resources = testCase.resources
+			err := img.ValidateLayers(context.TODO(), nil, descriptor)
+			if testCase.expected == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, testCase.expected)
+			}
+		})
+	}
+}
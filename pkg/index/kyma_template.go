@@ -0,0 +1,34 @@
+package index
+
+import (
+	"context"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const KymaTemplateField Field = v1beta1.KymaModuleTemplateField
+
+type KymaTemplateIndex struct{}
+
+func KymaTemplate() *KymaTemplateIndex {
+	return &KymaTemplateIndex{}
+}
+
+func (idx *KymaTemplateIndex) With(ctx context.Context, indexer client.FieldIndexer) error {
+	return indexer.IndexField(ctx, &v1beta1.Kyma{}, string(KymaTemplateField),
+		func(o client.Object) []string {
+			modules := o.(*v1beta1.Kyma).Status.Modules
+			templates := make([]string, 0, len(modules))
+			for _, module := range modules {
+				if module.Template.Name == "" {
+					continue
+				}
+				templates = append(templates, types.NamespacedName{
+					Namespace: module.Template.Namespace, Name: module.Template.Name,
+				}.String())
+			}
+			return templates
+		})
+}
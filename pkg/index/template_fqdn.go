@@ -7,7 +7,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-const TemplateFQDNField Field = "spec.descriptor.component.name"
+const TemplateFQDNField Field = v1beta1.ModuleTemplateFQDNField
 
 type TemplateFQDNIndex struct{}
 
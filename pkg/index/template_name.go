@@ -8,7 +8,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-const TemplateNameField Field = "metadata.name"
+const TemplateNameField Field = v1beta1.ModuleTemplateNameField
 
 type TemplateNameIndex struct{}
 
@@ -0,0 +1,142 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+)
+
+const (
+	metricKymaState                      = "lifecycle_mgr_kyma_state"
+	metricModuleState                    = "lifecycle_mgr_module_state"
+	metricTemplateLookupDuration         = "lifecycle_mgr_template_lookup_duration_seconds"
+	metricDescriptorVerificationDuration = "lifecycle_mgr_descriptor_verification_duration_seconds"
+	metricSKRListenerEvents              = "lifecycle_mgr_skr_listener_events_total"
+	metricRemoteSyncFailures             = "lifecycle_mgr_remote_sync_failures_total"
+
+	KymaNameLabel      = "kyma"
+	KymaNamespaceLabel = "namespace"
+	StateLabel         = "state"
+	ModuleNameLabel    = "module"
+	ChannelLabel       = "channel"
+	ControllerLabel    = "controller"
+)
+
+//nolint:gochecknoglobals
+var (
+	kymaStates = []v1beta1.State{
+		v1beta1.StateReady, v1beta1.StateProcessing, v1beta1.StateError, v1beta1.StateDeleting,
+	}
+
+	kymaStateGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: metricKymaState,
+		Help: "Indicates the Status.state for a given Kyma object",
+	}, []string{KymaNamespaceLabel, KymaNameLabel, StateLabel})
+
+	moduleStateGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: metricModuleState,
+		Help: "Indicates the Status.state for a given Module of a Kyma object",
+	}, []string{KymaNamespaceLabel, KymaNameLabel, ModuleNameLabel, ChannelLabel, StateLabel})
+
+	templateLookupHistogram = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name: metricTemplateLookupDuration,
+		Help: "Duration of the lookup of all ModuleTemplates for a Kyma object",
+	})
+
+	descriptorVerificationHistogram = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name: metricDescriptorVerificationDuration,
+		Help: "Duration of the signature verification of a single OCM Component Descriptor",
+	})
+
+	skrListenerEventsCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: metricSKRListenerEvents,
+		Help: "Number of events received by the SKR event listener",
+	}, []string{ControllerLabel})
+
+	remoteSyncFailuresCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: metricRemoteSyncFailures,
+		Help: "Number of failed synchronizations of a Kyma object with its remote cluster",
+	}, []string{KymaNamespaceLabel, KymaNameLabel})
+)
+
+//nolint:gochecknoinits
+func init() {
+	ctrlmetrics.Registry.MustRegister(
+		kymaStateGauge,
+		moduleStateGauge,
+		templateLookupHistogram,
+		descriptorVerificationHistogram,
+		skrListenerEventsCounter,
+		remoteSyncFailuresCounter,
+	)
+}
+
+// UpdateKymaStates sets the state gauges of the Kyma and all of its Modules.
+// Exactly one state series per Kyma and per Module has the value 1, all others are 0.
+// Series of Modules that are no longer part of the status are removed.
+func UpdateKymaStates(kyma *v1beta1.Kyma) {
+	for _, state := range kymaStates {
+		kymaStateGauge.With(prometheus.Labels{
+			KymaNamespaceLabel: kyma.GetNamespace(),
+			KymaNameLabel:      kyma.GetName(),
+			StateLabel:         string(state),
+		}).Set(boolToFloat(kyma.Status.State == state))
+	}
+
+	moduleStateGauge.DeletePartialMatch(kymaLabels(kyma.GetNamespace(), kyma.GetName()))
+	for _, module := range kyma.Status.Modules {
+		for _, state := range kymaStates {
+			moduleStateGauge.With(prometheus.Labels{
+				KymaNamespaceLabel: kyma.GetNamespace(),
+				KymaNameLabel:      kyma.GetName(),
+				ModuleNameLabel:    module.Name,
+				ChannelLabel:       module.Channel,
+				StateLabel:         string(state),
+			}).Set(boolToFloat(module.State == state))
+		}
+	}
+}
+
+// RemoveKymaMetrics removes all series of a Kyma that no longer exists.
+func RemoveKymaMetrics(kyma types.NamespacedName) {
+	labels := kymaLabels(kyma.Namespace, kyma.Name)
+	kymaStateGauge.DeletePartialMatch(labels)
+	moduleStateGauge.DeletePartialMatch(labels)
+	remoteSyncFailuresCounter.DeletePartialMatch(labels)
+}
+
+// ObserveTemplateLookup records the duration of a ModuleTemplate lookup that started at start.
+func ObserveTemplateLookup(start time.Time) {
+	templateLookupHistogram.Observe(time.Since(start).Seconds())
+}
+
+// ObserveDescriptorVerification records the duration of a descriptor verification that started at start.
+func ObserveDescriptorVerification(start time.Time) {
+	descriptorVerificationHistogram.Observe(time.Since(start).Seconds())
+}
+
+// RecordSKRListenerEvent counts an event received from the SKR for the given controller.
+func RecordSKRListenerEvent(controllerName string) {
+	skrListenerEventsCounter.WithLabelValues(controllerName).Inc()
+}
+
+// RecordRemoteSyncFailure counts a failed synchronization of the Kyma with its remote cluster.
+func RecordRemoteSyncFailure(kyma *v1beta1.Kyma) {
+	remoteSyncFailuresCounter.With(kymaLabels(kyma.GetNamespace(), kyma.GetName())).Inc()
+}
+
+// kymaLabels identifies the series of a Kyma, as Kymas of the same name may exist in several namespaces.
+func kymaLabels(namespace, name string) prometheus.Labels {
+	return prometheus.Labels{KymaNamespaceLabel: namespace, KymaNameLabel: name}
+}
+
+func boolToFloat(value bool) float64 {
+	if value {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,76 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+)
+
+// TestUpdateKymaStates compares the complete state gauges, so it does not run in parallel and starts with
+// empty gauges that are gathered from a registry of their own.
+func TestUpdateKymaStates(t *testing.T) {
+	kymaStateGauge.Reset()
+	moduleStateGauge.Reset()
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(kymaStateGauge, moduleStateGauge)
+
+	kyma := &v1beta1.Kyma{}
+	kyma.SetName("metrics-kyma")
+	kyma.SetNamespace("first")
+	kyma.Status.State = v1beta1.StateProcessing
+	kyma.Status.Modules = []v1beta1.ModuleStatus{
+		{Name: "module-a", Channel: "regular", State: v1beta1.StateReady},
+		{Name: "module-b", Channel: "fast", State: v1beta1.StateError},
+	}
+
+	UpdateKymaStates(kyma)
+	other := kyma.DeepCopy()
+	other.SetNamespace("second")
+	other.Status.Modules = nil
+	UpdateKymaStates(other)
+
+	expected := `
+# HELP lifecycle_mgr_kyma_state Indicates the Status.state for a given Kyma object
+# TYPE lifecycle_mgr_kyma_state gauge
+lifecycle_mgr_kyma_state{kyma="metrics-kyma",namespace="first",state="Deleting"} 0
+lifecycle_mgr_kyma_state{kyma="metrics-kyma",namespace="first",state="Error"} 0
+lifecycle_mgr_kyma_state{kyma="metrics-kyma",namespace="first",state="Processing"} 1
+lifecycle_mgr_kyma_state{kyma="metrics-kyma",namespace="first",state="Ready"} 0
+lifecycle_mgr_kyma_state{kyma="metrics-kyma",namespace="second",state="Deleting"} 0
+lifecycle_mgr_kyma_state{kyma="metrics-kyma",namespace="second",state="Error"} 0
+lifecycle_mgr_kyma_state{kyma="metrics-kyma",namespace="second",state="Processing"} 1
+lifecycle_mgr_kyma_state{kyma="metrics-kyma",namespace="second",state="Ready"} 0
+# HELP lifecycle_mgr_module_state Indicates the Status.state for a given Module of a Kyma object
+# TYPE lifecycle_mgr_module_state gauge
+lifecycle_mgr_module_state{channel="fast",kyma="metrics-kyma",module="module-b",namespace="first",state="Deleting"} 0
+lifecycle_mgr_module_state{channel="fast",kyma="metrics-kyma",module="module-b",namespace="first",state="Error"} 1
+lifecycle_mgr_module_state{channel="fast",kyma="metrics-kyma",module="module-b",namespace="first",state="Processing"} 0
+lifecycle_mgr_module_state{channel="fast",kyma="metrics-kyma",module="module-b",namespace="first",state="Ready"} 0
+`
+	kyma.Status.Modules = kyma.Status.Modules[1:]
+	UpdateKymaStates(kyma)
+	assert.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(expected),
+		"lifecycle_mgr_kyma_state", "lifecycle_mgr_module_state"))
+
+	RemoveKymaMetrics(client.ObjectKeyFromObject(kyma))
+	remaining := `
+# HELP lifecycle_mgr_kyma_state Indicates the Status.state for a given Kyma object
+# TYPE lifecycle_mgr_kyma_state gauge
+lifecycle_mgr_kyma_state{kyma="metrics-kyma",namespace="second",state="Deleting"} 0
+lifecycle_mgr_kyma_state{kyma="metrics-kyma",namespace="second",state="Error"} 0
+lifecycle_mgr_kyma_state{kyma="metrics-kyma",namespace="second",state="Processing"} 1
+lifecycle_mgr_kyma_state{kyma="metrics-kyma",namespace="second",state="Ready"} 0
+`
+	assert.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(remaining),
+		"lifecycle_mgr_kyma_state", "lifecycle_mgr_module_state"))
+
+	RemoveKymaMetrics(client.ObjectKeyFromObject(other))
+	assert.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(""),
+		"lifecycle_mgr_kyma_state", "lifecycle_mgr_module_state"))
+}
@@ -0,0 +1,106 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+)
+
+var (
+	ErrModuleDependencyCycle   = errors.New("module dependencies contain a cycle")
+	ErrModuleDependencyMissing = errors.New("module dependency is not part of the kyma")
+)
+
+// Refers determines if the given reference of a dependency points to the Module.
+func (m *Module) Refers(reference string) bool {
+	return m.ModuleName == reference || m.FQDN == reference
+}
+
+// Dependencies returns the references to all Modules that the Module depends on.
+func (m *Module) Dependencies() []string {
+	return m.Template.Spec.Dependencies
+}
+
+func (modules Modules) find(reference string) *Module {
+	for _, module := range modules {
+		if module.Refers(reference) {
+			return module
+		}
+	}
+	return nil
+}
+
+// InstallationLevels groups the Modules by their depth in the dependency graph. The first level contains all
+// Modules without dependencies, while every following level only depends on Modules of previous levels.
+// Within a level, the original order of the Modules is kept.
+func (modules Modules) InstallationLevels() ([]Modules, error) {
+	depths := make(map[*Module]int, len(modules))
+	maxDepth := 0
+	remaining := modules
+	for len(remaining) > 0 {
+		unresolved := make(Modules, 0, len(remaining))
+		for _, module := range remaining {
+			depth, resolved, err := modules.depthOf(module, depths)
+			if err != nil {
+				return nil, err
+			}
+			if !resolved {
+				unresolved = append(unresolved, module)
+				continue
+			}
+			depths[module] = depth
+			if depth > maxDepth {
+				maxDepth = depth
+			}
+		}
+		if len(unresolved) == len(remaining) {
+			names := make([]string, len(unresolved))
+			for i, module := range unresolved {
+				names[i] = module.ModuleName
+			}
+			return nil, fmt.Errorf("%w: %v", ErrModuleDependencyCycle, names)
+		}
+		remaining = unresolved
+	}
+
+	levels := make([]Modules, maxDepth+1)
+	for _, module := range modules {
+		levels[depths[module]] = append(levels[depths[module]], module)
+	}
+	return levels, nil
+}
+
+func (modules Modules) depthOf(module *Module, depths map[*Module]int) (int, bool, error) {
+	depth := 0
+	for _, reference := range module.Dependencies() {
+		dependency := modules.find(reference)
+		if dependency == nil {
+			return 0, false, fmt.Errorf("%w: %s required by %s", ErrModuleDependencyMissing,
+				reference, module.ModuleName)
+		}
+		dependencyDepth, resolved := depths[dependency]
+		if !resolved {
+			return 0, false, nil
+		}
+		if dependencyDepth+1 > depth {
+			depth = dependencyDepth + 1
+		}
+	}
+	return depth, true, nil
+}
+
+// DependenciesReady determines if the Manifests of all dependencies of the given Module are ready.
+func (modules Modules) DependenciesReady(module *Module) bool {
+	for _, reference := range module.Dependencies() {
+		dependency := modules.find(reference)
+		if dependency == nil {
+			return false
+		}
+		manifest, ok := dependency.Object.(*v1beta1.Manifest)
+		if !ok || v1beta1.State(manifest.Status.State) != v1beta1.StateReady {
+			return false
+		}
+	}
+	return true
+}
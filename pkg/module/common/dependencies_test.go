@@ -0,0 +1,117 @@
+package common_test
+
+import (
+	"testing"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+	"github.com/kyma-project/lifecycle-manager/pkg/module/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func moduleWithDependencies(name string, dependencies ...string) *common.Module {
+	return &common.Module{
+		ModuleName: name,
+		FQDN:       "kyma-project.io/module/" + name,
+		Template: &v1beta1.ModuleTemplate{
+			Spec: v1beta1.ModuleTemplateSpec{Dependencies: dependencies},
+		},
+		Object: &v1beta1.Manifest{},
+	}
+}
+
+func levelNames(levels []common.Modules) [][]string {
+	names := make([][]string, len(levels))
+	for i, level := range levels {
+		for _, module := range level {
+			names[i] = append(names[i], module.ModuleName)
+		}
+	}
+	return names
+}
+
+//nolint:funlen
+func TestModules_InstallationLevels(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		modules  common.Modules
+		expected [][]string
+		err      error
+	}{
+		{
+			"modules without dependencies share one level",
+			common.Modules{moduleWithDependencies("a"), moduleWithDependencies("b")},
+			[][]string{{"a", "b"}},
+			nil,
+		},
+		{
+			"dependencies are installed first",
+			common.Modules{
+				moduleWithDependencies("serverless", "eventing"),
+				moduleWithDependencies("eventing"),
+			},
+			[][]string{{"eventing"}, {"serverless"}},
+			nil,
+		},
+		{
+			"dependencies can be referenced by fqdn",
+			common.Modules{
+				moduleWithDependencies("c", "kyma-project.io/module/b"),
+				moduleWithDependencies("b", "a"),
+				moduleWithDependencies("a"),
+				moduleWithDependencies("d", "a"),
+			},
+			[][]string{{"a"}, {"b", "d"}, {"c"}},
+			nil,
+		},
+		{
+			"cycles are detected",
+			common.Modules{
+				moduleWithDependencies("a", "c"),
+				moduleWithDependencies("b", "a"),
+				moduleWithDependencies("c", "b"),
+				moduleWithDependencies("d"),
+			},
+			nil,
+			common.ErrModuleDependencyCycle,
+		},
+		{
+			"self references are detected as cycle",
+			common.Modules{moduleWithDependencies("a", "a")},
+			nil,
+			common.ErrModuleDependencyCycle,
+		},
+		{
+			"missing dependencies are detected",
+			common.Modules{moduleWithDependencies("serverless", "eventing")},
+			nil,
+			common.ErrModuleDependencyMissing,
+		},
+	}
+	for _, testCase := range tests {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			levels, err := testCase.modules.InstallationLevels()
+			if testCase.err != nil {
+				assert.ErrorIs(t, err, testCase.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expected, levelNames(levels))
+		})
+	}
+}
+
+func TestModules_DependenciesReady(t *testing.T) {
+	t.Parallel()
+	eventing := moduleWithDependencies("eventing")
+	serverless := moduleWithDependencies("serverless", "eventing")
+	modules := common.Modules{eventing, serverless}
+
+	assert.False(t, modules.DependenciesReady(serverless))
+
+	eventing.Object.(*v1beta1.Manifest).Status.State = "Ready"
+	assert.True(t, modules.DependenciesReady(serverless))
+	assert.True(t, modules.DependenciesReady(eventing))
+}
@@ -0,0 +1,47 @@
+package common
+
+import (
+	"github.com/Masterminds/semver/v3"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+)
+
+// DowngradePolicy determines how Modules are handled that would lower the version of an installed Module.
+type DowngradePolicy string
+
+const (
+	// DowngradePolicyBlock keeps the installed version of a Module instead of downgrading it,
+	// unless the Kyma explicitly allows the downgrade with v1beta1.AllowDowngradeAnnotation.
+	DowngradePolicyBlock DowngradePolicy = "Block"
+	// DowngradePolicyAllow applies downgrades of Modules like any other version change.
+	DowngradePolicyAllow DowngradePolicy = "Allow"
+)
+
+// BlockDowngrades marks all Modules that would lower the Version of an already installed Module as
+// DowngradeBlocked, unless the Kyma allows their downgrade. It returns the blocked Modules.
+// Versions that are not valid semantic versions are never considered a downgrade.
+func (modules Modules) BlockDowngrades(kyma *v1beta1.Kyma) Modules {
+	var blocked Modules
+	for _, module := range modules {
+		moduleStatus := kyma.GetModuleStatus(module.ModuleName)
+		if moduleStatus == nil || !isDowngrade(moduleStatus.Version, module.Version) ||
+			kyma.AllowsDowngradeOf(module.ModuleName) {
+			continue
+		}
+		module.DowngradeBlocked = true
+		blocked = append(blocked, module)
+	}
+	return blocked
+}
+
+func isDowngrade(installedVersion, desiredVersion string) bool {
+	installed, err := semver.NewVersion(installedVersion)
+	if err != nil {
+		return false
+	}
+	desired, err := semver.NewVersion(desiredVersion)
+	if err != nil {
+		return false
+	}
+	return desired.LessThan(installed)
+}
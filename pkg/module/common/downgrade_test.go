@@ -0,0 +1,92 @@
+package common_test
+
+import (
+	"testing"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+	"github.com/kyma-project/lifecycle-manager/pkg/module/common"
+	"github.com/stretchr/testify/assert"
+)
+
+//nolint:funlen
+func TestModules_BlockDowngrades(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name             string
+		installedVersion string
+		desiredVersion   string
+		allowedModules   string
+		expectBlocked    bool
+	}{
+		{
+			"upgrade is not blocked",
+			"1.0.0",
+			"1.1.0",
+			"",
+			false,
+		},
+		{
+			"same version is not blocked",
+			"1.0.0",
+			"1.0.0",
+			"",
+			false,
+		},
+		{
+			"downgrade is blocked",
+			"2.0.0",
+			"1.0.0",
+			"",
+			true,
+		},
+		{
+			"downgrade is blocked if another module is allowed",
+			"2.0.0",
+			"1.0.0",
+			"other-module",
+			true,
+		},
+		{
+			"downgrade is allowed through annotation",
+			"2.0.0",
+			"1.0.0",
+			"other-module, test-module",
+			false,
+		},
+		{
+			"invalid versions are not considered a downgrade",
+			"latest",
+			"1.0.0",
+			"",
+			false,
+		},
+		{
+			"modules without status are not blocked",
+			"",
+			"1.0.0",
+			"",
+			false,
+		},
+	}
+	for _, testCase := range tests {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			kyma := &v1beta1.Kyma{}
+			if testCase.installedVersion != "" {
+				kyma.Status.Modules = []v1beta1.ModuleStatus{
+					{Name: "test-module", Version: testCase.installedVersion},
+				}
+			}
+			if testCase.allowedModules != "" {
+				kyma.SetAnnotations(map[string]string{v1beta1.AllowDowngradeAnnotation: testCase.allowedModules})
+			}
+			module := plannableModule("test-module", v1beta1.DefaultChannel, testCase.desiredVersion)
+
+			blocked := common.Modules{module}.BlockDowngrades(kyma)
+
+			assert.Equal(t, testCase.expectBlocked, module.DowngradeBlocked)
+			assert.Equal(t, testCase.expectBlocked, len(blocked) == 1)
+		})
+	}
+}
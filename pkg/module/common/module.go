@@ -19,10 +19,35 @@ type (
 		Version          string
 		Template         *v1beta1.ModuleTemplate
 		TemplateOutdated bool
+		// Resolution describes how the Template was chosen among several candidates in the same channel.
+		Resolution string
+		// UpgradePending is set if applying the Module would change the Version of an already installed Module
+		// outside the maintenance window of the Kyma. Such Modules are not applied until the window opens.
+		UpgradePending bool
+		// DependenciesPending is set if the Manifests of the Modules the Module depends on are not yet ready.
+		// Such Modules are held back until all of their dependencies are ready.
+		DependenciesPending bool
+		// DowngradeBlocked is set if applying the Module would lower the Version of an already installed Module
+		// while downgrades are blocked. Such Modules keep their installed Version.
+		DowngradeBlocked bool
 		client.Object
 	}
 )
 
+// DeferVersionChanges marks all Modules that would change the Version of an already installed Module
+// as UpgradePending.
+func (modules Modules) DeferVersionChanges(kyma *v1beta1.Kyma) {
+	for _, module := range modules {
+		for _, moduleStatus := range kyma.Status.Modules {
+			if moduleStatus.Name == module.ModuleName &&
+				moduleStatus.Version != "" && moduleStatus.Version != module.Version {
+				module.UpgradePending = true
+				break
+			}
+		}
+	}
+}
+
 func (m *Module) Logger(base logr.Logger) logr.Logger {
 	return base.WithValues(
 		"fqdn", m.FQDN,
@@ -0,0 +1,43 @@
+package common
+
+import (
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+)
+
+// Plan determines the changes applying the Modules would cause for the given Kyma.
+// Modules without a status are created, Modules whose status differs in version or channel are updated and
+// all Modules that are tracked in the status but no longer part of the spec are pruned.
+func (modules Modules) Plan(kyma *v1beta1.Kyma) *v1beta1.KymaPlan {
+	plan := &v1beta1.KymaPlan{}
+
+	for _, module := range modules {
+		planned := v1beta1.PlannedModule{
+			Name:    module.ModuleName,
+			Channel: module.Template.Spec.Channel,
+			Version: module.Version,
+		}
+		moduleStatus := kyma.GetModuleStatus(module.ModuleName)
+		if moduleStatus == nil {
+			plan.Create = append(plan.Create, planned)
+			continue
+		}
+		if moduleStatus.Version != planned.Version || moduleStatus.Channel != planned.Channel {
+			planned.PreviousChannel = moduleStatus.Channel
+			planned.PreviousVersion = moduleStatus.Version
+			plan.Update = append(plan.Update, planned)
+		}
+	}
+
+	for _, moduleStatus := range kyma.Status.Modules {
+		if modules.find(moduleStatus.Name) != nil {
+			continue
+		}
+		plan.Prune = append(plan.Prune, v1beta1.PlannedModule{
+			Name:            moduleStatus.Name,
+			PreviousChannel: moduleStatus.Channel,
+			PreviousVersion: moduleStatus.Version,
+		})
+	}
+
+	return plan
+}
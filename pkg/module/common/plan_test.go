@@ -0,0 +1,50 @@
+package common_test
+
+import (
+	"testing"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+	"github.com/kyma-project/lifecycle-manager/pkg/module/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func plannableModule(name, channel, version string) *common.Module {
+	return &common.Module{
+		ModuleName: name,
+		Version:    version,
+		Template: &v1beta1.ModuleTemplate{
+			Spec: v1beta1.ModuleTemplateSpec{Channel: channel},
+		},
+		Object: &v1beta1.Manifest{},
+	}
+}
+
+func TestModules_Plan(t *testing.T) {
+	t.Parallel()
+	kyma := &v1beta1.Kyma{}
+	kyma.Status.Modules = []v1beta1.ModuleStatus{
+		{Name: "unchanged", Channel: "regular", Version: "1.0.0"},
+		{Name: "upgraded", Channel: "regular", Version: "1.0.0"},
+		{Name: "switched", Channel: "regular", Version: "1.0.0"},
+		{Name: "removed", Channel: "fast", Version: "2.0.0"},
+	}
+	modules := common.Modules{
+		plannableModule("unchanged", "regular", "1.0.0"),
+		plannableModule("upgraded", "regular", "1.1.0"),
+		plannableModule("switched", "fast", "1.0.0"),
+		plannableModule("added", "regular", "0.1.0"),
+	}
+
+	plan := modules.Plan(kyma)
+
+	assert.Equal(t, []v1beta1.PlannedModule{
+		{Name: "added", Channel: "regular", Version: "0.1.0"},
+	}, plan.Create)
+	assert.Equal(t, []v1beta1.PlannedModule{
+		{Name: "upgraded", Channel: "regular", Version: "1.1.0", PreviousChannel: "regular", PreviousVersion: "1.0.0"},
+		{Name: "switched", Channel: "fast", Version: "1.0.0", PreviousChannel: "regular", PreviousVersion: "1.0.0"},
+	}, plan.Update)
+	assert.Equal(t, []v1beta1.PlannedModule{
+		{Name: "removed", PreviousChannel: "fast", PreviousVersion: "2.0.0"},
+	}, plan.Prune)
+}
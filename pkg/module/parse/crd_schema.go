@@ -0,0 +1,223 @@
+package parse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	v1extensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+)
+
+var ErrResourceDoesNotMatchSchema = errors.New("resource does not match the schema of its CRD")
+
+// SchemaValidation validates the custom resource of a Manifest against the schema of its CRD.
+type SchemaValidation interface {
+	Validate(ctx context.Context, manifest *v1beta1.Manifest) error
+}
+
+// CRDSchemaValidation looks up the CRD of the custom resource in the cluster the Manifest is targeted at
+// and validates the resource against its openAPIV3Schema. If the CRD is not yet installed,
+// e.g. because the Module is installed for the first time, the resource is not validated.
+// The CRD is read by its name, which is determined through the RESTMapper of the reader if it has one.
+type CRDSchemaValidation struct {
+	ControlPlane client.Reader
+	// Runtime is used to look up CRDs of Manifests targeting the remote cluster, if it is set.
+	Runtime client.Reader
+}
+
+func (v *CRDSchemaValidation) Validate(ctx context.Context, manifest *v1beta1.Manifest) error {
+	resource := manifest.Spec.Resource
+	if resource == nil {
+		return nil
+	}
+	reader := v.ControlPlane
+	if manifest.Spec.Remote && v.Runtime != nil {
+		reader = v.Runtime
+	}
+
+	gvk := resource.GroupVersionKind()
+	name, err := crdName(reader, gvk)
+	if meta.IsNoMatchError(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("could not determine CRD for schema validation: %w", err)
+	}
+	crd := &v1extensions.CustomResourceDefinition{}
+	if err := reader.Get(ctx, client.ObjectKey{Name: name}, crd); k8serrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("could not get CRD %s for schema validation: %w", name, err)
+	}
+
+	if crd.Spec.Names.Kind != gvk.Kind {
+		return nil
+	}
+	for _, version := range crd.Spec.Versions {
+		if version.Name == gvk.Version && version.Schema != nil && version.Schema.OpenAPIV3Schema != nil {
+			return ValidateAgainstSchema(resource, version.Schema.OpenAPIV3Schema)
+		}
+	}
+	return nil
+}
+
+// crdName returns the name of the CRD of the kind, which is made of the plural of the kind and its group.
+// Without a RESTMapper, the plural is guessed from the kind.
+func crdName(reader client.Reader, gvk schema.GroupVersionKind) (string, error) {
+	resource, _ := meta.UnsafeGuessKindToResource(gvk)
+	if mapped, ok := reader.(interface{ RESTMapper() meta.RESTMapper }); ok {
+		mapping, err := mapped.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+		if err != nil {
+			return "", err
+		}
+		resource = mapping.Resource
+	}
+	return resource.Resource + "." + gvk.Group, nil
+}
+
+// ValidateAgainstSchema validates the types, enums, required and unknown fields of the resource
+// against the given structural schema. Metadata is validated by the API server and skipped here.
+func ValidateAgainstSchema(resource *unstructured.Unstructured, schema *v1extensions.JSONSchemaProps) error {
+	content := make(map[string]any, len(resource.Object))
+	for key, value := range resource.Object {
+		if key != "apiVersion" && key != "kind" && key != "metadata" {
+			content[key] = value
+		}
+	}
+	properties := make(map[string]v1extensions.JSONSchemaProps, len(schema.Properties))
+	for key, property := range schema.Properties {
+		if key != "apiVersion" && key != "kind" && key != "metadata" {
+			properties[key] = property
+		}
+	}
+	objectSchema := schema.DeepCopy()
+	objectSchema.Properties = properties
+
+	if errs := validateValue("", content, objectSchema); len(errs) > 0 {
+		return fmt.Errorf("%w: %s %s: %s", ErrResourceDoesNotMatchSchema,
+			resource.GroupVersionKind().Kind, resource.GetName(), strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+//nolint:cyclop
+func validateValue(path string, value any, schema *v1extensions.JSONSchemaProps) []string {
+	if value == nil {
+		if schema.Nullable {
+			return nil
+		}
+		return []string{path + " must not be null"}
+	}
+
+	var errs []string
+	if len(schema.Enum) > 0 && !inEnum(value, schema.Enum) {
+		errs = append(errs, fmt.Sprintf("%s has unsupported value %v", path, value))
+	}
+
+	switch schema.Type {
+	case "object":
+		object, ok := value.(map[string]any)
+		if !ok {
+			return append(errs, path+" must be of type object")
+		}
+		errs = append(errs, validateObject(path, object, schema)...)
+	case "array":
+		array, ok := value.([]any)
+		if !ok {
+			return append(errs, path+" must be of type array")
+		}
+		if schema.Items != nil && schema.Items.Schema != nil {
+			for i, item := range array {
+				errs = append(errs, validateValue(fmt.Sprintf("%s[%d]", path, i), item, schema.Items.Schema)...)
+			}
+		}
+	case "string":
+		if _, ok := value.(string); !ok && !schema.XIntOrString {
+			errs = append(errs, path+" must be of type string")
+		}
+	case "integer":
+		if !isInteger(value) {
+			errs = append(errs, path+" must be of type integer")
+		}
+	case "number":
+		if !isInteger(value) && reflect.TypeOf(value).Kind() != reflect.Float64 {
+			errs = append(errs, path+" must be of type number")
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			errs = append(errs, path+" must be of type boolean")
+		}
+	}
+	return errs
+}
+
+func validateObject(path string, object map[string]any, schema *v1extensions.JSONSchemaProps) []string {
+	var errs []string
+	for _, required := range schema.Required {
+		if _, ok := object[required]; !ok {
+			errs = append(errs, fmt.Sprintf("%s.%s is required", path, required))
+		}
+	}
+	preserveUnknown := schema.XPreserveUnknownFields != nil && *schema.XPreserveUnknownFields
+	for key, field := range object {
+		fieldPath := path + "." + key
+		if property, ok := schema.Properties[key]; ok {
+			property := property
+			errs = append(errs, validateValue(fieldPath, field, &property)...)
+			continue
+		}
+		if schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
+			errs = append(errs, validateValue(fieldPath, field, schema.AdditionalProperties.Schema)...)
+			continue
+		}
+		if !preserveUnknown && (schema.AdditionalProperties == nil || !schema.AdditionalProperties.Allows) {
+			errs = append(errs, fieldPath+" is not a known field")
+		}
+	}
+	return errs
+}
+
+func isInteger(value any) bool {
+	switch typed := value.(type) {
+	case int, int32, int64:
+		return true
+	case float64:
+		return typed == float64(int64(typed))
+	}
+	return false
+}
+
+func inEnum(value any, enum []v1extensions.JSON) bool {
+	for _, allowed := range enum {
+		var allowedValue any
+		if err := json.Unmarshal(allowed.Raw, &allowedValue); err != nil {
+			continue
+		}
+		if reflect.DeepEqual(normalize(allowedValue), normalize(value)) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalize converts all numbers into float64, as decoded JSON and unstructured content differ in number types.
+func normalize(value any) any {
+	switch typed := value.(type) {
+	case int:
+		return float64(typed)
+	case int32:
+		return float64(typed)
+	case int64:
+		return float64(typed)
+	}
+	return value
+}
@@ -0,0 +1,138 @@
+package parse_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1extensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+	"github.com/kyma-project/lifecycle-manager/pkg/module/parse"
+)
+
+func sampleSchema() *v1extensions.JSONSchemaProps {
+	preserve := true
+	return &v1extensions.JSONSchemaProps{
+		Type: "object",
+		Properties: map[string]v1extensions.JSONSchemaProps{
+			"apiVersion": {Type: "string"},
+			"kind":       {Type: "string"},
+			"metadata":   {Type: "object"},
+			"spec": {
+				Type:     "object",
+				Required: []string{"replicas"},
+				Properties: map[string]v1extensions.JSONSchemaProps{
+					"replicas": {Type: "integer"},
+					"mode": {Type: "string", Enum: []v1extensions.JSON{
+						{Raw: []byte(`"fast"`)}, {Raw: []byte(`"safe"`)},
+					}},
+					"values": {Type: "object", XPreserveUnknownFields: &preserve},
+				},
+			},
+		},
+	}
+}
+
+func sampleResource(spec map[string]any) *unstructured.Unstructured {
+	resource := &unstructured.Unstructured{Object: map[string]any{"spec": spec}}
+	resource.SetAPIVersion("operator.kyma-project.io/v1alpha1")
+	resource.SetKind("Sample")
+	resource.SetName("sample")
+	return resource
+}
+
+func TestValidateAgainstSchema(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		spec     map[string]any
+		expected string
+	}{
+		{
+			"valid resource",
+			map[string]any{"replicas": int64(3), "mode": "safe", "values": map[string]any{"any": "thing"}},
+			"",
+		},
+		{
+			"wrong type",
+			map[string]any{"replicas": "three"},
+			".spec.replicas must be of type integer",
+		},
+		{
+			"missing required field",
+			map[string]any{"mode": "fast"},
+			".spec.replicas is required",
+		},
+		{
+			"unsupported enum value",
+			map[string]any{"replicas": float64(1), "mode": "reckless"},
+			".spec.mode has unsupported value reckless",
+		},
+		{
+			"unknown field",
+			map[string]any{"replicas": int64(1), "replica": int64(1)},
+			".spec.replica is not a known field",
+		},
+	}
+	for _, testCase := range tests {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			err := parse.ValidateAgainstSchema(sampleResource(testCase.spec), sampleSchema())
+			if testCase.expected == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, parse.ErrResourceDoesNotMatchSchema)
+			assert.ErrorContains(t, err, testCase.expected)
+		})
+	}
+}
+
+// sampleValidation validates resources against the sample CRD, which is installed in the control plane.
+func sampleValidation(t *testing.T) *parse.CRDSchemaValidation {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1extensions.AddToScheme(scheme))
+	crd := &v1extensions.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "samples.operator.kyma-project.io"},
+		Spec: v1extensions.CustomResourceDefinitionSpec{
+			Group: "operator.kyma-project.io",
+			Names: v1extensions.CustomResourceDefinitionNames{Kind: "Sample", Plural: "samples"},
+			Versions: []v1extensions.CustomResourceDefinitionVersion{{
+				Name:   "v1alpha1",
+				Schema: &v1extensions.CustomResourceValidation{OpenAPIV3Schema: sampleSchema()},
+			}},
+		},
+	}
+	// the reader is stripped of the RESTMapper of the fake client, which does not know the sample kind.
+	reader := struct{ client.Reader }{fake.NewClientBuilder().WithScheme(scheme).WithObjects(crd).Build()}
+	return &parse.CRDSchemaValidation{ControlPlane: reader}
+}
+
+func TestCRDSchemaValidation_Validate(t *testing.T) {
+	t.Parallel()
+	validation := sampleValidation(t)
+	validate := func(resource *unstructured.Unstructured) error {
+		manifest := &v1beta1.Manifest{}
+		manifest.Spec.Resource = resource
+		return validation.Validate(context.TODO(), manifest)
+	}
+
+	assert.NoError(t, validate(sampleResource(map[string]any{"replicas": int64(1)})))
+	assert.ErrorIs(t, validate(sampleResource(map[string]any{"replicas": "one"})),
+		parse.ErrResourceDoesNotMatchSchema)
+	assert.ErrorIs(t, validate(sampleResource(map[string]any{"replicas": int64(1), "replica": int64(1)})),
+		parse.ErrResourceDoesNotMatchSchema)
+
+	notInstalled := sampleResource(map[string]any{"replicas": "one"})
+	notInstalled.SetKind("Other")
+	assert.NoError(t, validate(notInstalled))
+}
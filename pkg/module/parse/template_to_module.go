@@ -1,36 +1,47 @@
 package parse
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	jsonpatch "github.com/evanphx/json-patch"
 	ocm "github.com/gardener/component-spec/bindings-go/apis/v2"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kyma-project/lifecycle-manager/pkg/channel"
 	"github.com/kyma-project/lifecycle-manager/pkg/img"
+	"github.com/kyma-project/lifecycle-manager/pkg/metrics"
 	"github.com/kyma-project/lifecycle-manager/pkg/module/common"
 	"github.com/kyma-project/lifecycle-manager/pkg/signature"
 )
 
 type ModuleConversionSettings struct {
 	signature.Verification
+	SchemaValidation
 }
 
 var (
 	ErrTemplateNotFound        = errors.New("template was not found")
 	ErrUndefinedTargetToRemote = errors.New("target to remote relation undefined")
 	ErrDefaultConfigParsing    = errors.New("defaultConfig could not be parsed")
+	ErrModuleConfigMerge       = errors.New("module config could not be merged into the custom resource")
 )
 
 func GenerateModulesFromTemplates(
-	kyma *v1beta1.Kyma, templates channel.ModuleTemplatesByModuleName, verification signature.Verification,
+	ctx context.Context,
+	kyma *v1beta1.Kyma,
+	templates channel.ModuleTemplatesByModuleName,
+	verification signature.Verification,
+	validation SchemaValidation,
 ) (common.Modules, error) {
 	// these are the actual modules
-	modules, err := templatesToModules(kyma, templates,
-		&ModuleConversionSettings{Verification: verification})
+	modules, err := templatesToModules(ctx, kyma, templates,
+		&ModuleConversionSettings{Verification: verification, SchemaValidation: validation})
 	if err != nil {
 		return nil, fmt.Errorf("cannot convert templates: %w", err)
 	}
@@ -39,6 +50,7 @@ func GenerateModulesFromTemplates(
 }
 
 func templatesToModules(
+	ctx context.Context,
 	kyma *v1beta1.Kyma,
 	templates channel.ModuleTemplatesByModuleName,
 	settings *ModuleConversionSettings,
@@ -74,10 +86,16 @@ func templatesToModules(
 				template.ModuleTemplate.Spec.Data.SetNamespace(kyma.GetNamespace())
 			}
 		}
-		var obj client.Object
+		var obj *v1beta1.Manifest
 		if obj, err = NewManifestFromTemplate(module, template.ModuleTemplate, settings.Verification); err != nil {
 			return nil, err
 		}
+		// only customised resources are validated, the defaults are expected to be valid for their module
+		if module.Config != nil && settings.SchemaValidation != nil {
+			if err := settings.Validate(ctx, obj); err != nil {
+				return nil, fmt.Errorf("invalid config for module %s: %w", module.Name, err)
+			}
+		}
 		// we name the manifest after the module name
 		obj.SetName(name)
 		// to have correct owner references, the manifest must always have the same namespace as kyma
@@ -88,6 +106,7 @@ func templatesToModules(
 			Version:          version,
 			Template:         template.ModuleTemplate,
 			TemplateOutdated: template.Outdated,
+			Resolution:       template.Resolution,
 			Object:           obj,
 		})
 	}
@@ -109,7 +128,11 @@ func NewManifestFromTemplate(
 	case v1beta1.CustomResourcePolicyCreateAndDelete:
 		fallthrough
 	default:
-		manifest.Spec.Resource = template.Spec.Data.DeepCopy()
+		resource, err := mergeConfigIntoResource(template.Spec.Data.DeepCopy(), module.Config)
+		if err != nil {
+			return nil, err
+		}
+		manifest.Spec.Resource = resource
 	}
 
 	var descriptor *ocm.ComponentDescriptor
@@ -120,7 +143,10 @@ func NewManifestFromTemplate(
 		return nil, fmt.Errorf("could not decode the descriptor: %w", err)
 	}
 
-	if err := signature.Verify(descriptor, verification); err != nil {
+	verificationStart := time.Now()
+	err = signature.Verify(descriptor, template.Spec.OCMDescriptor.Raw, verification)
+	metrics.ObserveDescriptorVerification(verificationStart)
+	if err != nil {
 		return nil, fmt.Errorf("could not verify descriptor: %w", err)
 	}
 
@@ -135,6 +161,28 @@ func NewManifestFromTemplate(
 	return manifest, nil
 }
 
+// mergeConfigIntoResource applies the config of a Module as a JSON Merge Patch onto the default resource.
+func mergeConfigIntoResource(
+	resource *unstructured.Unstructured, config *runtime.RawExtension,
+) (*unstructured.Unstructured, error) {
+	if config == nil || len(config.Raw) == 0 {
+		return resource, nil
+	}
+	original, err := resource.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrModuleConfigMerge, err.Error())
+	}
+	merged, err := jsonpatch.MergePatch(original, config.Raw)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrModuleConfigMerge, err.Error())
+	}
+	mergedResource := &unstructured.Unstructured{}
+	if err := mergedResource.UnmarshalJSON(merged); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrModuleConfigMerge, err.Error())
+	}
+	return mergedResource, nil
+}
+
 func translateLayersAndMergeIntoManifest(
 	manifest *v1beta1.Manifest, layers img.Layers,
 ) error {
@@ -161,7 +209,7 @@ func insertLayerIntoManifest(
 			Repo:               ociImage.Repo,
 			Name:               ociImage.Name,
 			Ref:                ociImage.Ref,
-			Type:               img.OCIRepresentationType,
+			Type:               v1beta1.RefTypeMetadata(ociImage.Type),
 			CredSecretSelector: ociImage.CredSecretSelector,
 		}
 	default:
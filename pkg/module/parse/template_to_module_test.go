@@ -0,0 +1,86 @@
+package parse_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+	"github.com/kyma-project/lifecycle-manager/pkg/channel"
+	"github.com/kyma-project/lifecycle-manager/pkg/module/parse"
+	"github.com/kyma-project/lifecycle-manager/pkg/signature"
+	"github.com/kyma-project/lifecycle-manager/pkg/testutils"
+)
+
+//nolint:funlen
+func TestGenerateModulesFromTemplates_Config(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		config        *runtime.RawExtension
+		expectedSpec  map[string]any
+		expectedError error
+	}{
+		{
+			"no config",
+			nil,
+			map[string]any{"replicas": int64(1), "mode": "fast"},
+			nil,
+		},
+		{
+			"valid config",
+			&runtime.RawExtension{Raw: []byte(`{"spec":{"replicas":3,"mode":null}}`)},
+			map[string]any{"replicas": int64(3)},
+			nil,
+		},
+		{
+			"invalid config",
+			&runtime.RawExtension{Raw: []byte(`{"spec":`)},
+			nil,
+			parse.ErrModuleConfigMerge,
+		},
+		{
+			"unknown field config",
+			&runtime.RawExtension{Raw: []byte(`{"spec":{"replica":3}}`)},
+			nil,
+			parse.ErrResourceDoesNotMatchSchema,
+		},
+		{
+			"config not matching the schema",
+			&runtime.RawExtension{Raw: []byte(`{"spec":{"mode":"reckless"}}`)},
+			nil,
+			parse.ErrResourceDoesNotMatchSchema,
+		},
+	}
+	for _, testCase := range tests {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			module := v1beta1.Module{
+				Name: "sample", ControllerName: "manifest", Channel: v1beta1.DefaultChannel, Config: testCase.config,
+			}
+			template, err := testutils.ModuleTemplateFactory(module,
+				*sampleResource(map[string]any{"replicas": int64(1), "mode": "fast"}))
+			require.NoError(t, err)
+			kyma := testutils.NewTestKyma("config")
+			kyma.Spec.Modules = []v1beta1.Module{module}
+
+			modules, err := parse.GenerateModulesFromTemplates(context.TODO(), kyma,
+				channel.ModuleTemplatesByModuleName{module.Name: {ModuleTemplate: template}},
+				signature.NoSignatureVerification, sampleValidation(t))
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, err, testCase.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, modules, 1)
+			manifest, ok := modules[0].Object.(*v1beta1.Manifest)
+			require.True(t, ok)
+			assert.Equal(t, "Sample", manifest.Spec.Resource.GetKind())
+			assert.Equal(t, testCase.expectedSpec, manifest.Spec.Resource.Object["spec"])
+		})
+	}
+}
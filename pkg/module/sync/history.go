@@ -0,0 +1,78 @@
+package sync
+
+import (
+	"github.com/Masterminds/semver/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+)
+
+// recordModuleHistory records a ModuleHistoryEntry if the latest status of a Module was installed, changed its
+// version or switched its channel compared to the previous status. Otherwise, it only tracks the outcome
+// of the latest recorded operation of the Module.
+func recordModuleHistory(kyma *v1beta1.Kyma, previous *v1beta1.ModuleStatus, latest v1beta1.ModuleStatus) {
+	operation, changed := moduleOperation(previous, latest)
+	if !changed {
+		kyma.UpdateModuleHistoryOutcome(latest.Name, v1beta1.OutcomeFromState(latest.State))
+		return
+	}
+	kyma.RecordModuleHistory(v1beta1.ModuleHistoryEntry{
+		Module:             latest.Name,
+		Operation:          operation,
+		Version:            latest.Version,
+		Channel:            latest.Channel,
+		TemplateGeneration: latest.Template.GetGeneration(),
+		Timestamp:          metav1.Now(),
+		Outcome:            v1beta1.OutcomeFromState(latest.State),
+	})
+}
+
+// recordModuleRemoval records the removal of a Module once it starts and tracks its outcome until it is gone.
+func recordModuleRemoval(kyma *v1beta1.Kyma, moduleStatus v1beta1.ModuleStatus, gone bool) {
+	outcome := v1beta1.ModuleOperationOutcomePending
+	if gone {
+		outcome = v1beta1.ModuleOperationOutcomeSucceeded
+	}
+	if moduleStatus.State == v1beta1.StateDeleting {
+		kyma.UpdateModuleHistoryOutcome(moduleStatus.Name, outcome)
+		return
+	}
+	kyma.RecordModuleHistory(v1beta1.ModuleHistoryEntry{
+		Module:             moduleStatus.Name,
+		Operation:          v1beta1.ModuleOperationRemoval,
+		Version:            moduleStatus.Version,
+		Channel:            moduleStatus.Channel,
+		TemplateGeneration: moduleStatus.Template.GetGeneration(),
+		Timestamp:          metav1.Now(),
+		Outcome:            outcome,
+	})
+}
+
+func moduleOperation(
+	previous *v1beta1.ModuleStatus, latest v1beta1.ModuleStatus,
+) (v1beta1.ModuleOperation, bool) {
+	switch {
+	case previous == nil || previous.State == v1beta1.StateDeleting:
+		return v1beta1.ModuleOperationInstall, true
+	case previous.Channel != latest.Channel:
+		return v1beta1.ModuleOperationChannelSwitch, true
+	case previous.Version != latest.Version:
+		if isLowerVersion(latest.Version, previous.Version) {
+			return v1beta1.ModuleOperationDowngrade, true
+		}
+		return v1beta1.ModuleOperationUpgrade, true
+	}
+	return "", false
+}
+
+func isLowerVersion(version, than string) bool {
+	parsedVersion, err := semver.NewVersion(version)
+	if err != nil {
+		return false
+	}
+	parsedThan, err := semver.NewVersion(than)
+	if err != nil {
+		return false
+	}
+	return parsedVersion.LessThan(parsedThan)
+}
@@ -40,9 +40,42 @@ func (r *RunnerImpl) Sync(ctx context.Context, kyma *v1beta1.Kyma,
 	ssaStart := time.Now()
 	baseLogger := ctrlLog.FromContext(ctx)
 
-	results := make(chan error, len(modules))
-	for _, module := range modules {
+	levels, err := modules.InstallationLevels()
+	if err != nil {
+		return fmt.Errorf("could not determine installation order of modules: %w", err)
+	}
+
+	// levels are applied one after another, so that the state of all dependencies of a module is known
+	// before it is applied. Modules within one level do not depend on each other and are applied in parallel.
+	var errs []error
+	for _, level := range levels {
+		errs = append(errs, r.syncLevel(ctx, kyma, modules, level)...)
+	}
+	ssaFinish := time.Since(ssaStart)
+	if len(errs) != 0 {
+		return fmt.Errorf("ServerSideApply failed (after %s): %w", ssaFinish, types.NewMultiError(errs))
+	}
+	baseLogger.V(log.DebugLevel).Info("ServerSideApply finished", "time", ssaFinish)
+	return nil
+}
+
+func (r *RunnerImpl) syncLevel(ctx context.Context, kyma *v1beta1.Kyma,
+	modules common.Modules, level common.Modules,
+) []error {
+	baseLogger := ctrlLog.FromContext(ctx)
+
+	results := make(chan error, len(level))
+	for _, module := range level {
 		go func(module *common.Module) {
+			if !modules.DependenciesReady(module) {
+				if err := r.holdModule(ctx, module); err != nil {
+					results <- fmt.Errorf("could not hold back module %s: %w", module.GetName(), err)
+					return
+				}
+				module.Logger(baseLogger).V(log.DebugLevel).Info("holding back module until dependencies are ready")
+				results <- nil
+				return
+			}
 			if err := r.updateModule(ctx, kyma, module); err != nil {
 				results <- fmt.Errorf("could not update module %s: %w", module.GetName(), err)
 				return
@@ -52,17 +85,12 @@ func (r *RunnerImpl) Sync(ctx context.Context, kyma *v1beta1.Kyma,
 		}(module)
 	}
 	var errs []error
-	for i := 0; i < len(modules); i++ {
+	for i := 0; i < len(level); i++ {
 		if err := <-results; err != nil {
 			errs = append(errs, err)
 		}
 	}
-	ssaFinish := time.Since(ssaStart)
-	if len(errs) != 0 {
-		return fmt.Errorf("ServerSideApply failed (after %s): %w", ssaFinish, types.NewMultiError(errs))
-	}
-	baseLogger.V(log.DebugLevel).Info("ServerSideApply finished", "time", ssaFinish)
-	return nil
+	return errs
 }
 
 func (r *RunnerImpl) getModule(ctx context.Context, module client.Object) error {
@@ -72,6 +100,13 @@ func (r *RunnerImpl) getModule(ctx context.Context, module client.Object) error
 func (r *RunnerImpl) updateModule(ctx context.Context, kyma *v1beta1.Kyma,
 	module *common.Module,
 ) error {
+	if module.UpgradePending || module.DowngradeBlocked {
+		if err := r.fetchInstalledModule(ctx, module); err != nil {
+			return fmt.Errorf("error fetching manifest %s with pending version change: %w",
+				client.ObjectKeyFromObject(module), err)
+		}
+		return nil
+	}
 	if err := r.setupModule(module, kyma); err != nil {
 		return err
 	}
@@ -92,6 +127,33 @@ func (r *RunnerImpl) updateModule(ctx context.Context, kyma *v1beta1.Kyma,
 	return nil
 }
 
+// holdModule marks the Module as waiting for its dependencies and replaces its desired state with the
+// currently installed state, if there is one.
+func (r *RunnerImpl) holdModule(ctx context.Context, module *common.Module) error {
+	module.DependenciesPending = true
+	if err := r.fetchInstalledModule(ctx, module); err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		module.GetObjectKind().SetGroupVersionKind(v1beta1.GroupVersion.WithKind(v1beta1.ManifestKind))
+	}
+	return nil
+}
+
+// fetchInstalledModule replaces the desired state of a Module with the currently installed state,
+// so that the status can be tracked without applying the desired state.
+func (r *RunnerImpl) fetchInstalledModule(ctx context.Context, module *common.Module) error {
+	installed := &v1beta1.Manifest{}
+	installed.SetName(module.GetName())
+	installed.SetNamespace(module.GetNamespace())
+	if err := r.getModule(ctx, installed); err != nil {
+		return err
+	}
+	installed.SetGroupVersionKind(v1beta1.GroupVersion.WithKind(v1beta1.ManifestKind))
+	module.Object = installed
+	return nil
+}
+
 func (r *RunnerImpl) setupModule(module *common.Module, kyma *v1beta1.Kyma) error {
 	// set labels
 	module.ApplyLabelsAndAnnotations(kyma)
@@ -118,11 +180,12 @@ func (r *RunnerImpl) updateModuleStatusFromExistingModules(modules common.Module
 		manifestAPIVersion, manifestKind := module.Object.GetObjectKind().GroupVersionKind().ToAPIVersionAndKind()
 		templateAPIVersion, templateKind := module.Template.GetObjectKind().GroupVersionKind().ToAPIVersionAndKind()
 		latestModuleStatus := v1beta1.ModuleStatus{
-			Name:    module.ModuleName,
-			FQDN:    module.FQDN,
-			State:   stateFromManifest(module.Object),
-			Channel: module.Template.Spec.Channel,
-			Version: module.Version,
+			Name:       module.ModuleName,
+			FQDN:       module.FQDN,
+			State:      stateFromManifest(module.Object),
+			Channel:    module.Template.Spec.Channel,
+			Version:    module.Version,
+			Resolution: module.Resolution,
 			Manifest: v1beta1.TrackingObject{
 				PartialMeta: v1beta1.PartialMetaFromObject(module.Object),
 				TypeMeta:    metav1.TypeMeta{Kind: manifestKind, APIVersion: manifestAPIVersion},
@@ -132,12 +195,34 @@ func (r *RunnerImpl) updateModuleStatusFromExistingModules(modules common.Module
 				TypeMeta:    metav1.TypeMeta{Kind: templateKind, APIVersion: templateAPIVersion},
 			},
 		}
-		if len(kyma.Status.Modules) < idx+1 {
-			kyma.Status.Modules = append(kyma.Status.Modules, latestModuleStatus)
-		} else {
-			kyma.Status.Modules[idx] = latestModuleStatus
+		if module.UpgradePending || module.DowngradeBlocked {
+			latestModuleStatus = pendingModuleStatus(kyma, module)
 		}
+		if module.DependenciesPending && latestModuleStatus.State == "" {
+			latestModuleStatus.State = v1beta1.StateProcessing
+		}
+		recordModuleHistory(kyma, kyma.GetModuleStatus(module.ModuleName), latestModuleStatus)
+		kyma.SetModuleStatus(latestModuleStatus)
+	}
+}
+
+// pendingModuleStatus keeps the tracked Template and Version of an installed Module with a pending upgrade
+// or a blocked downgrade, records the Version that is not applied and only refreshes the state of its Manifest.
+func pendingModuleStatus(kyma *v1beta1.Kyma, module *common.Module) v1beta1.ModuleStatus {
+	moduleStatus := kyma.GetModuleStatus(module.ModuleName)
+	if moduleStatus == nil {
+		return v1beta1.ModuleStatus{}
+	}
+	pending := *moduleStatus
+	pending.State = stateFromManifest(module.Object)
+	pending.Manifest.PartialMeta = v1beta1.PartialMetaFromObject(module.Object)
+	pending.PendingVersion, pending.BlockedDowngradeVersion = "", ""
+	if module.DowngradeBlocked {
+		pending.BlockedDowngradeVersion = module.Version
+	} else {
+		pending.PendingVersion = module.Version
 	}
+	return pending
 }
 
 func stateFromManifest(obj client.Object) v1beta1.State {
@@ -149,17 +234,31 @@ func stateFromManifest(obj client.Object) v1beta1.State {
 	}
 }
 
+// deleteNoLongerExistingModuleStatus keeps the status of removed Modules in the Deleting state
+// until their Manifest is gone and only then stops tracking them.
 func (r *RunnerImpl) deleteNoLongerExistingModuleStatus(ctx context.Context, kyma *v1beta1.Kyma) {
-	moduleStatusArr := kyma.GetNoLongerExistingModuleStatus()
-	for idx := range moduleStatusArr {
-		moduleStatus := moduleStatusArr[idx]
+	for _, moduleStatus := range kyma.GetNoLongerExistingModuleStatus() {
 		module := unstructured.Unstructured{}
 		module.SetGroupVersionKind(moduleStatus.Manifest.GroupVersionKind())
 		module.SetName(moduleStatus.Manifest.GetName())
 		module.SetNamespace(moduleStatus.Manifest.GetNamespace())
 		err := r.getModule(ctx, &module)
 		if errors.IsNotFound(err) {
-			kyma.Status.Modules = append(kyma.Status.Modules[:idx], kyma.Status.Modules[idx+1:]...)
+			recordModuleRemoval(kyma, moduleStatus, true)
+			kyma.RemoveModuleStatus(moduleStatus.Name)
+			continue
+		}
+		if err != nil {
+			ctrlLog.FromContext(ctx).Error(err, "could not determine deletion progress of module",
+				"module", moduleStatus.Name)
+			continue
+		}
+		recordModuleRemoval(kyma, moduleStatus, false)
+		moduleStatus.State = v1beta1.StateDeleting
+		if moduleStatus.DeletionTriggeredAt == nil {
+			now := metav1.Now()
+			moduleStatus.DeletionTriggeredAt = &now
 		}
+		kyma.SetModuleStatus(moduleStatus)
 	}
 }
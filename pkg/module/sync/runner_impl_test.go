@@ -0,0 +1,153 @@
+package sync_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+	declarative "github.com/kyma-project/lifecycle-manager/pkg/declarative/v2"
+	"github.com/kyma-project/lifecycle-manager/pkg/module/common"
+	"github.com/kyma-project/lifecycle-manager/pkg/module/sync"
+)
+
+func TestRunnerImpl_SyncModuleStatus_Removal(t *testing.T) {
+	t.Parallel()
+	manifest := &v1beta1.Manifest{ObjectMeta: metav1.ObjectMeta{Name: "kyma-removed", Namespace: metav1.NamespaceDefault}}
+	runner, kyma := newRunner(t, manifest)
+	kyma.Status.Modules = []v1beta1.ModuleStatus{moduleStatus("removed", "1.0.0", manifest)}
+
+	before := time.Now().Add(-time.Second)
+	runner.SyncModuleStatus(context.TODO(), kyma, nil)
+	removing := kyma.GetModuleStatus("removed")
+	require.NotNil(t, removing)
+	assert.Equal(t, v1beta1.StateDeleting, removing.State)
+	require.NotNil(t, removing.DeletionTriggeredAt)
+	assert.False(t, removing.DeletionTriggeredAt.Time.Before(before))
+	triggeredAt := *removing.DeletionTriggeredAt
+
+	runner.SyncModuleStatus(context.TODO(), kyma, nil)
+	assert.Equal(t, triggeredAt, *kyma.GetModuleStatus("removed").DeletionTriggeredAt)
+
+	require.NoError(t, runner.Delete(context.TODO(), manifest))
+	runner.SyncModuleStatus(context.TODO(), kyma, nil)
+	assert.Nil(t, kyma.GetModuleStatus("removed"))
+}
+
+//nolint:funlen
+func TestRunnerImpl_SyncModuleStatus_History(t *testing.T) {
+	t.Parallel()
+	runner, kyma := newRunner(t)
+	kyma.Spec.Modules = []v1beta1.Module{{Name: "sample"}}
+	steps := []struct {
+		name              string
+		modules           common.Modules
+		expectedOperation v1beta1.ModuleOperation
+		expectedVersion   string
+		expectedOutcome   v1beta1.ModuleOperationOutcome
+		expectedEntries   int
+	}{
+		{
+			"install",
+			common.Modules{historyModule("1.0.0", v1beta1.DefaultChannel, v1beta1.StateProcessing)},
+			v1beta1.ModuleOperationInstall, "1.0.0", v1beta1.ModuleOperationOutcomePending, 1,
+		},
+		{
+			"installed",
+			common.Modules{historyModule("1.0.0", v1beta1.DefaultChannel, v1beta1.StateReady)},
+			v1beta1.ModuleOperationInstall, "1.0.0", v1beta1.ModuleOperationOutcomeSucceeded, 1,
+		},
+		{
+			"upgrade",
+			common.Modules{historyModule("1.1.0", v1beta1.DefaultChannel, v1beta1.StateProcessing)},
+			v1beta1.ModuleOperationUpgrade, "1.1.0", v1beta1.ModuleOperationOutcomePending, 2,
+		},
+		{
+			"failed upgrade",
+			common.Modules{historyModule("1.1.0", v1beta1.DefaultChannel, v1beta1.StateError)},
+			v1beta1.ModuleOperationUpgrade, "1.1.0", v1beta1.ModuleOperationOutcomeFailed, 2,
+		},
+		{
+			"downgrade",
+			common.Modules{historyModule("1.0.0", v1beta1.DefaultChannel, v1beta1.StateReady)},
+			v1beta1.ModuleOperationDowngrade, "1.0.0", v1beta1.ModuleOperationOutcomeSucceeded, 3,
+		},
+		{
+			"channel switch",
+			common.Modules{historyModule("1.2.0", "fast", v1beta1.StateReady)},
+			v1beta1.ModuleOperationChannelSwitch, "1.2.0", v1beta1.ModuleOperationOutcomeSucceeded, 4,
+		},
+	}
+	for _, step := range steps {
+		runner.SyncModuleStatus(context.TODO(), kyma, step.modules)
+		require.Len(t, kyma.Status.ModuleHistory, step.expectedEntries, step.name)
+		latest := kyma.Status.ModuleHistory[len(kyma.Status.ModuleHistory)-1]
+		assert.Equal(t, "sample", latest.Module, step.name)
+		assert.Equal(t, step.expectedOperation, latest.Operation, step.name)
+		assert.Equal(t, step.expectedVersion, latest.Version, step.name)
+		assert.Equal(t, step.expectedOutcome, latest.Outcome, step.name)
+	}
+
+	manifest := historyModule("1.2.0", "fast", v1beta1.StateReady).Object
+	require.NoError(t, runner.Create(context.TODO(), manifest))
+	kyma.Spec.Modules = nil
+	runner.SyncModuleStatus(context.TODO(), kyma, nil)
+	removal := kyma.Status.ModuleHistory[len(kyma.Status.ModuleHistory)-1]
+	assert.Equal(t, v1beta1.ModuleOperationRemoval, removal.Operation)
+	assert.Equal(t, "1.2.0", removal.Version)
+	assert.Equal(t, "fast", removal.Channel)
+	assert.Equal(t, v1beta1.ModuleOperationOutcomePending, removal.Outcome)
+
+	runner.SyncModuleStatus(context.TODO(), kyma, nil)
+	assert.Len(t, kyma.Status.ModuleHistory, 5)
+
+	require.NoError(t, runner.Delete(context.TODO(), manifest))
+	runner.SyncModuleStatus(context.TODO(), kyma, nil)
+	require.Len(t, kyma.Status.ModuleHistory, 5)
+	removal = kyma.Status.ModuleHistory[4]
+	assert.Equal(t, v1beta1.ModuleOperationRemoval, removal.Operation)
+	assert.Equal(t, v1beta1.ModuleOperationOutcomeSucceeded, removal.Outcome)
+	assert.Nil(t, kyma.GetModuleStatus("sample"))
+}
+
+func newRunner(t *testing.T, objects ...client.Object) (*sync.RunnerImpl, *v1beta1.Kyma) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1beta1.AddToScheme(scheme))
+	kyma := &v1beta1.Kyma{ObjectMeta: metav1.ObjectMeta{Name: "kyma", Namespace: metav1.NamespaceDefault}}
+	return sync.New(fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()), kyma
+}
+
+func moduleStatus(name, version string, manifest *v1beta1.Manifest) v1beta1.ModuleStatus {
+	return v1beta1.ModuleStatus{
+		Name:    name,
+		Channel: v1beta1.DefaultChannel,
+		Version: version,
+		State:   v1beta1.StateReady,
+		Manifest: v1beta1.TrackingObject{
+			TypeMeta:    metav1.TypeMeta{Kind: v1beta1.ManifestKind, APIVersion: v1beta1.GroupVersion.String()},
+			PartialMeta: v1beta1.PartialMetaFromObject(manifest),
+		},
+	}
+}
+
+// historyModule returns the Module "sample" in the version and channel, whose Manifest is in the state.
+func historyModule(version, channel string, state v1beta1.State) *common.Module {
+	manifest := &v1beta1.Manifest{
+		TypeMeta:   metav1.TypeMeta{Kind: v1beta1.ManifestKind, APIVersion: v1beta1.GroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{Name: "kyma-sample", Namespace: metav1.NamespaceDefault},
+	}
+	manifest.Status.State = declarative.State(state)
+	template := &v1beta1.ModuleTemplate{ObjectMeta: metav1.ObjectMeta{
+		Name: "sample-" + channel, Namespace: metav1.NamespaceDefault, Generation: 1,
+	}}
+	template.Spec.Channel = channel
+	return &common.Module{ModuleName: "sample", Version: version, Template: template, Object: manifest}
+}
@@ -0,0 +1,26 @@
+package ocmextensions
+
+import ocm "github.com/gardener/component-spec/bindings-go/apis/v2"
+
+const (
+	// CTFRepositoryType is the repository context type of an OCM common transport format (CTF) archive
+	// on the filesystem, which is used to install modules without access to a registry.
+	CTFRepositoryType = "CommonTransportFormat"
+	// CTFRepositoryTypeVersioned is the versioned variant of CTFRepositoryType that is accepted as well.
+	CTFRepositoryTypeVersioned = CTFRepositoryType + "/v1"
+)
+
+var _ ocm.TypedObjectAccessor = &CTFRepository{}
+
+// CTFRepository describes a CTF archive, which stores local blobs as blobs/<algorithm>.<digest>.
+type CTFRepository struct {
+	ocm.ObjectType `json:",inline"`
+	// FilePath is the path of the archive, either a directory or a (gzipped) tar.
+	FilePath string `json:"filePath"`
+	// FileFormat is the format of the archive, i.e. directory, tar or tgz.
+	FileFormat string `json:"fileFormat,omitempty"`
+}
+
+func (*CTFRepository) GetType() string {
+	return CTFRepositoryType
+}
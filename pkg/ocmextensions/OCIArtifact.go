@@ -0,0 +1,20 @@
+package ocmextensions
+
+import ocm "github.com/gardener/component-spec/bindings-go/apis/v2"
+
+// OCIArtifactType is the access type of an OCI artifact referenced by a full image reference in OCM v3.
+// It is the successor of the ociRegistry access type of the v2 schema.
+const OCIArtifactType = "ociArtifact"
+
+var _ ocm.TypedObjectAccessor = &OCIArtifactAccess{}
+
+// OCIArtifactAccess describes the access to an OCI artifact independent of the repository context.
+type OCIArtifactAccess struct {
+	ocm.ObjectType `json:",inline"`
+	// ImageReference is the tagged or digest-pinned reference of the artifact, e.g. ghcr.io/kyma/chart:1.0.0.
+	ImageReference string `json:"imageReference"`
+}
+
+func (*OCIArtifactAccess) GetType() string {
+	return OCIArtifactType
+}
@@ -196,6 +196,8 @@ func (c *RemoteCatalog) prepareForSSA(moduleTemplate *v1beta1.ModuleTemplate) {
 	moduleTemplate.SetResourceVersion("")
 	moduleTemplate.SetUID("")
 	moduleTemplate.SetManagedFields([]metav1.ManagedFieldsEntry{})
+	// the status lists Kymas of the control plane and is meaningless in the runtime
+	moduleTemplate.Status = v1beta1.ModuleTemplateStatus{}
 
 	if c.settings.Namespace != "" {
 		moduleTemplate.SetNamespace(c.settings.Namespace)
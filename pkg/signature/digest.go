@@ -0,0 +1,72 @@
+package signature
+
+import (
+	"crypto"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	v2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"github.com/gardener/component-spec/bindings-go/apis/v2/signatures"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1/codec/v3alpha1"
+)
+
+var (
+	ErrNormalisationAlgorithm = errors.New("normalisation algorithm is not supported")
+	ErrDigestMismatch         = errors.New("digest of the signature does not match the descriptor")
+	ErrDescriptorSource       = errors.New("the descriptor in its original schema is required to calculate its digest")
+)
+
+// VerifyDigest calculates the digest of the descriptor with the hash and normalisation algorithm of the signed
+// digest and compares both, so that a signature copied onto a modified descriptor is rejected.
+// Descriptors normalised from the v3alpha1 schema are signed in their original form, which has to be passed
+// as source, as it cannot be restored from the v2 model.
+func VerifyDigest(descriptor *v2.ComponentDescriptor, source []byte, digest v2.DigestSpec) error {
+	hashFunc, err := hashFunction(digest.HashAlgorithm)
+	if err != nil {
+		return err
+	}
+	var calculated string
+	if v3alpha1.IsNormalised(descriptor) {
+		calculated, err = digestV3(source, digest.NormalisationAlgorithm, hashFunc)
+	} else {
+		calculated, err = digestV2(descriptor, digest, hashFunc)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to calculate digest of %s:%s: %w", descriptor.GetName(), descriptor.GetVersion(), err)
+	}
+	if !strings.EqualFold(calculated, digest.Value) {
+		return fmt.Errorf("%w: %s:%s", ErrDigestMismatch, descriptor.GetName(), descriptor.GetVersion())
+	}
+	return nil
+}
+
+func digestV2(descriptor *v2.ComponentDescriptor, digest v2.DigestSpec, hashFunc crypto.Hash) (string, error) {
+	if digest.NormalisationAlgorithm != string(v2.JsonNormalisationV1) {
+		return "", fmt.Errorf("%w: %s", ErrNormalisationAlgorithm, digest.NormalisationAlgorithm)
+	}
+	calculated, err := signatures.HashForComponentDescriptor(*descriptor, signatures.Hasher{
+		HashFunction: hashFunc.New(), AlgorithmName: digest.HashAlgorithm,
+	})
+	if err != nil {
+		return "", err
+	}
+	return calculated.Value, nil
+}
+
+func digestV3(source []byte, normalisationAlgorithm string, hashFunc crypto.Hash) (string, error) {
+	if !v3alpha1.IsV3(source) {
+		return "", ErrDescriptorSource
+	}
+	normalised, err := v3alpha1.Normalise(source, normalisationAlgorithm)
+	if errors.Is(err, v3alpha1.ErrNormalisationAlgorithm) {
+		return "", fmt.Errorf("%w: %s", ErrNormalisationAlgorithm, normalisationAlgorithm)
+	} else if err != nil {
+		return "", err
+	}
+	hash := hashFunc.New()
+	_, _ = hash.Write(normalised)
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
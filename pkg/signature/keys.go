@@ -0,0 +1,247 @@
+package signature
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	v2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"github.com/gardener/component-spec/bindings-go/apis/v2/signatures"
+)
+
+const (
+	// ECDSA defines the type for ECDSA signatures in ASN.1 form.
+	ECDSA = "ECDSA"
+	// Ed25519 defines the type for Ed25519 signatures.
+	Ed25519 = "Ed25519"
+
+	// MediaTypeECDSASignature defines the media type for a plain, hex encoded ECDSA signature.
+	MediaTypeECDSASignature = "application/vnd.ocm.signature.ecdsa"
+	// MediaTypeEd25519Signature defines the media type for a plain, hex encoded Ed25519 signature.
+	MediaTypeEd25519Signature = "application/vnd.ocm.signature.ed25519"
+
+	certificatePEMBlockType = "CERTIFICATE"
+)
+
+// hashFunctions maps the names of hash algorithms used by the gardener and the OCM tooling to their hash.
+var hashFunctions = map[string]crypto.Hash{ //nolint:gochecknoglobals
+	signatures.SHA256: crypto.SHA256,
+	"SHA-256":         crypto.SHA256,
+}
+
+var (
+	ErrDecodePEM              = errors.New("unable to decode pem formatted block in key")
+	ErrSignatureMediaType     = errors.New("signature media type is not supported")
+	ErrSignatureAlgorithm     = errors.New("signature algorithm does not match the public key")
+	ErrSignatureInvalid       = errors.New("signature does not match the digest")
+	ErrHashAlgorithm          = errors.New("hash algorithm is not supported")
+	ErrNoPublicKey            = errors.New("no public key available to verify a signature without certificate")
+	ErrNoTrustedRoots         = errors.New("no trusted root certificates available to verify a certificate chain")
+	ErrCertificateUntrusted   = errors.New("certificate chain of the signature is not trusted")
+	ErrCertificateKeyUsage    = errors.New("certificate is not allowed to create digital signatures")
+	ErrCertificateIdentity    = errors.New("certificate is not issued for the signature name")
+	ErrNoCertificatesInBundle = errors.New("no certificates found in CA bundle")
+)
+
+// Verifier is a signatures.Verifier for RSA, ECDSA and Ed25519 signatures. Signatures in PEM form may carry
+// the certificate chain of the signer after the signature block, in which case the chain is validated against
+// the trusted roots, and the signature is verified with the public key of the leaf certificate.
+// All other signatures are verified with the configured public key.
+type Verifier struct {
+	PublicKey crypto.PublicKey
+	Roots     *x509.CertPool
+	// Now returns the time certificates are validated at, it defaults to time.Now.
+	Now func() time.Time
+}
+
+// Verify checks that the digest of the signature matches the descriptor, and that the signature was created
+// over this digest, returns an error on verification failure. Descriptors normalised from the v3alpha1 schema
+// are verified with VerifyDigest on their original form and VerifySignature instead.
+func (v *Verifier) Verify(descriptor v2.ComponentDescriptor, signature v2.Signature) error {
+	if err := VerifyDigest(&descriptor, nil, signature.Digest); err != nil {
+		return err
+	}
+	return v.VerifySignature(signature)
+}
+
+// VerifySignature checks that the signature was created over its digest, without comparing the digest
+// to a descriptor, returns an error on verification failure.
+func (v *Verifier) VerifySignature(signature v2.Signature) error {
+	signatureBytes, chain, err := decodeSignature(signature.Signature)
+	if err != nil {
+		return err
+	}
+
+	publicKey := v.PublicKey
+	if len(chain) > 0 {
+		if publicKey, err = v.verifyChain(chain, signature.Name); err != nil {
+			return err
+		}
+	} else if publicKey == nil {
+		return fmt.Errorf("%w: %s", ErrNoPublicKey, signature.Name)
+	}
+
+	hashFunc, err := hashFunction(signature.Digest.HashAlgorithm)
+	if err != nil {
+		return err
+	}
+	digest, err := hex.DecodeString(signature.Digest.Value)
+	if err != nil {
+		return fmt.Errorf("unable to hex decode digest %s: %w", signature.Digest.Value, err)
+	}
+	return verifyWithKey(publicKey, signature.Signature.Algorithm, hashFunc, digest, signatureBytes)
+}
+
+// verifyChain validates the expiry and key usage of the leaf certificate and its chain to one of the roots.
+// The leaf certificate must be issued for the signature name as its common name, so that a certificate
+// issued for one signature cannot be used to create signatures of another name.
+func (v *Verifier) verifyChain(chain []*x509.Certificate, signatureName string) (crypto.PublicKey, error) {
+	if v.Roots == nil {
+		return nil, ErrNoTrustedRoots
+	}
+	now := time.Now
+	if v.Now != nil {
+		now = v.Now
+	}
+
+	leaf := chain[0]
+	intermediates := x509.NewCertPool()
+	for _, cert := range chain[1:] {
+		intermediates.AddCert(cert)
+	}
+	if _, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         v.Roots,
+		Intermediates: intermediates,
+		CurrentTime:   now(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}); err != nil {
+		return nil, fmt.Errorf("%w: %s: %s", ErrCertificateUntrusted, leaf.Subject, err.Error())
+	}
+	if leaf.KeyUsage != 0 && leaf.KeyUsage&x509.KeyUsageDigitalSignature == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrCertificateKeyUsage, leaf.Subject)
+	}
+	if leaf.Subject.CommonName != signatureName {
+		return nil, fmt.Errorf("%w: %s is not issued for signature %s", ErrCertificateIdentity, leaf.Subject, signatureName)
+	}
+	return leaf.PublicKey, nil
+}
+
+// decodeSignature returns the signature bytes and, for signatures in PEM form, the certificate chain of the signer.
+func decodeSignature(spec v2.SignatureSpec) ([]byte, []*x509.Certificate, error) {
+	switch spec.MediaType {
+	case v2.MediaTypeRSASignature, MediaTypeECDSASignature, MediaTypeEd25519Signature:
+		signatureBytes, err := hex.DecodeString(spec.Value)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to hex decode signature %s: %w", spec.Value, err)
+		}
+		return signatureBytes, nil, nil
+	case v2.MediaTypePEM:
+		var signatureBytes []byte
+		var chain []*x509.Certificate
+		rest := []byte(spec.Value)
+		for block, next := pem.Decode(rest); block != nil; block, next = pem.Decode(rest) {
+			rest = next
+			switch block.Type {
+			case v2.SignaturePEMBlockType:
+				if signatureBytes != nil {
+					return nil, nil, fmt.Errorf("%w: more than one signature block", ErrSignatureMediaType)
+				}
+				signatureBytes = block.Bytes
+			case certificatePEMBlockType:
+				cert, err := x509.ParseCertificate(block.Bytes)
+				if err != nil {
+					return nil, nil, fmt.Errorf("unable to parse certificate of signature: %w", err)
+				}
+				chain = append(chain, cert)
+			}
+		}
+		if signatureBytes == nil {
+			return nil, nil, fmt.Errorf("%w: no signature block", ErrSignatureMediaType)
+		}
+		return signatureBytes, chain, nil
+	default:
+		return nil, nil, fmt.Errorf("%w: %s", ErrSignatureMediaType, spec.MediaType)
+	}
+}
+
+// verifyWithKey verifies the signature of the digest. RSA signatures use PKCS #1 v1.5, ECDSA signatures
+// are ASN.1 encoded, and Ed25519 signatures are created over the digest itself.
+func verifyWithKey(publicKey crypto.PublicKey, algorithm string, hashFunc crypto.Hash, digest, sig []byte) error {
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		if err := checkAlgorithm(algorithm, v2.RSAPKCS1v15); err != nil {
+			return err
+		}
+		if err := rsa.VerifyPKCS1v15(key, hashFunc, digest, sig); err != nil {
+			return fmt.Errorf("%w: %s", ErrSignatureInvalid, err.Error())
+		}
+	case *ecdsa.PublicKey:
+		if err := checkAlgorithm(algorithm, ECDSA); err != nil {
+			return err
+		}
+		if !ecdsa.VerifyASN1(key, digest, sig) {
+			return ErrSignatureInvalid
+		}
+	case ed25519.PublicKey:
+		if err := checkAlgorithm(algorithm, Ed25519); err != nil {
+			return err
+		}
+		if !ed25519.Verify(key, digest, sig) {
+			return ErrSignatureInvalid
+		}
+	default:
+		return fmt.Errorf("public key error: %w - type is %T", ErrPublicKeyWrongType, key)
+	}
+	return nil
+}
+
+func hashFunction(algorithm string) (crypto.Hash, error) {
+	hashFunc, ok := hashFunctions[algorithm]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrHashAlgorithm, algorithm)
+	}
+	return hashFunc, nil
+}
+
+// checkAlgorithm ensures a signature declaring its algorithm is not verified with a key of another type.
+func checkAlgorithm(algorithm, expected string) error {
+	if algorithm != "" && algorithm != expected {
+		return fmt.Errorf("%w: %s instead of %s", ErrSignatureAlgorithm, algorithm, expected)
+	}
+	return nil
+}
+
+// ParsePublicKey parses an RSA, ECDSA or Ed25519 public key in the PKIX, ASN.1 DER form,
+// see x509.ParsePKIXPublicKey.
+func ParsePublicKey(pemData []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(pemData)
+	if block == nil {
+		return nil, ErrDecodePEM
+	}
+	untypedKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse public key: %w", err)
+	}
+	switch key := untypedKey.(type) {
+	case *rsa.PublicKey, *ecdsa.PublicKey, ed25519.PublicKey:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("public key error: %w - type is %T", ErrPublicKeyWrongType, key)
+	}
+}
+
+// ParseCABundle parses the PEM encoded root certificates that certificate chains of signatures are validated against.
+func ParseCABundle(pemData []byte) (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pemData) {
+		return nil, ErrNoCertificatesInBundle
+	}
+	return pool, nil
+}
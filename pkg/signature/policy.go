@@ -0,0 +1,99 @@
+package signature
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	v2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+)
+
+var ErrInvalidVerificationPolicy = errors.New("verification policy is invalid")
+
+// PolicyFor resolves the verification policy of the Kyma from its spec, the annotation of its namespace and,
+// if neither sets a policy, from whether verification is enabled for the operator.
+func (settings *VerificationSettings) PolicyFor(
+	ctx context.Context, kyma *v1beta1.Kyma,
+) (v1beta1.VerificationPolicy, error) {
+	if kyma.Spec.VerificationPolicy != "" {
+		return kyma.Spec.VerificationPolicy, nil
+	}
+
+	namespace := &v1.Namespace{}
+	err := settings.Get(ctx, client.ObjectKey{Name: kyma.GetNamespace()}, namespace)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return "", fmt.Errorf("could not get namespace for verification policy: %w", err)
+	}
+	if policy, ok := namespace.GetAnnotations()[v1beta1.VerificationPolicyAnnotation]; ok {
+		switch v1beta1.VerificationPolicy(policy) {
+		case v1beta1.VerificationPolicyEnforce, v1beta1.VerificationPolicyWarn, v1beta1.VerificationPolicyOff:
+			return v1beta1.VerificationPolicy(policy), nil
+		default:
+			return "", fmt.Errorf("%w: %s in annotation of namespace %s",
+				ErrInvalidVerificationPolicy, policy, kyma.GetNamespace())
+		}
+	}
+
+	if settings.EnableVerification {
+		return v1beta1.VerificationPolicyEnforce, nil
+	}
+	return v1beta1.VerificationPolicyOff, nil
+}
+
+// Warnings collects the failed verifications of descriptors under the Warn policy.
+type Warnings struct {
+	messages []string
+}
+
+// List returns the sorted warnings.
+func (w *Warnings) List() []string {
+	if w == nil {
+		return nil
+	}
+	messages := append([]string(nil), w.messages...)
+	sort.Strings(messages)
+	return messages
+}
+
+// NewPolicyVerification creates the Verification for the policy, regardless of whether verification is enabled
+// for the operator. Under the Warn policy, a failed verification does not fail the descriptor,
+// but is collected in the returned Warnings instead.
+func (settings *VerificationSettings) NewPolicyVerification(
+	ctx context.Context, namespace string, policy v1beta1.VerificationPolicy,
+) (Verification, *Warnings, error) {
+	switch policy {
+	case v1beta1.VerificationPolicyOff:
+		return NoSignatureVerification, nil, nil
+	case v1beta1.VerificationPolicyWarn:
+		warnings := &Warnings{}
+		verification, err := settings.newVerification(ctx, namespace)
+		return func(descriptor *v2.ComponentDescriptor, source []byte) (string, error) {
+			if err != nil {
+				warnings.add(descriptor, err)
+				return "", nil
+			}
+			key, verificationErr := verification(descriptor, source)
+			if verificationErr != nil {
+				warnings.add(descriptor, verificationErr)
+				return "", nil
+			}
+			return key, nil
+		}, warnings, nil
+	case v1beta1.VerificationPolicyEnforce:
+		fallthrough
+	default:
+		verification, err := settings.newVerification(ctx, namespace)
+		return verification, nil, err
+	}
+}
+
+func (w *Warnings) add(descriptor *v2.ComponentDescriptor, err error) {
+	w.messages = append(w.messages, fmt.Sprintf("module %s:%s could not be verified: %s",
+		descriptor.GetName(), descriptor.GetVersion(), err.Error()))
+}
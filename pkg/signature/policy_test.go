@@ -0,0 +1,94 @@
+package signature_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+
+	v2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+	"github.com/kyma-project/lifecycle-manager/pkg/signature"
+)
+
+func TestVerificationSettings_PolicyFor(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name                string
+		kymaPolicy          v1beta1.VerificationPolicy
+		namespacePolicy     string
+		enabledForOperator  bool
+		expected            v1beta1.VerificationPolicy
+		expectedErrorTarget error
+	}{
+		{"kyma overrides namespace", v1beta1.VerificationPolicyOff, "Enforce", true, v1beta1.VerificationPolicyOff, nil},
+		{"namespace overrides operator", "", "Warn", true, v1beta1.VerificationPolicyWarn, nil},
+		{"enabled for operator", "", "", true, v1beta1.VerificationPolicyEnforce, nil},
+		{"disabled for operator", "", "", false, v1beta1.VerificationPolicyOff, nil},
+		{"invalid namespace annotation", "", "strict", true, "", signature.ErrInvalidVerificationPolicy},
+	}
+	for _, testCase := range tests {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "kcp-system"}}
+			if testCase.namespacePolicy != "" {
+				namespace.Annotations = map[string]string{v1beta1.VerificationPolicyAnnotation: testCase.namespacePolicy}
+			}
+			settings := &signature.VerificationSettings{
+				Client:             fake.NewClientBuilder().WithObjects(namespace).Build(),
+				EnableVerification: testCase.enabledForOperator,
+			}
+			kyma := &v1beta1.Kyma{ObjectMeta: metav1.ObjectMeta{Name: "kyma", Namespace: "kcp-system"}}
+			kyma.Spec.VerificationPolicy = testCase.kymaPolicy
+
+			policy, err := settings.PolicyFor(context.TODO(), kyma)
+			assert.ErrorIs(t, err, testCase.expectedErrorTarget)
+			assert.Equal(t, testCase.expected, policy)
+		})
+	}
+}
+
+func TestVerificationSettings_NewPolicyVerification(t *testing.T) {
+	t.Parallel()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	other, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	settings := &signature.VerificationSettings{
+		Client:              fake.NewClientBuilder().WithObjects(keySecret(t, "key", key, "", "")).Build(),
+		ValidSignatureNames: []string{signatureName},
+	}
+	descriptor := descriptor()
+	descriptor.Signatures = []v2.Signature{
+		hexSignature(t, signature.ECDSA, signature.MediaTypeECDSASignature, sign(t, other)),
+	}
+
+	verification, warnings, err := settings.NewPolicyVerification(
+		context.TODO(), "kcp-system", v1beta1.VerificationPolicyEnforce)
+	require.NoError(t, err)
+	_, err = verification(&descriptor, nil)
+	assert.ErrorIs(t, err, signature.ErrSignatureInvalid)
+	assert.Empty(t, warnings.List())
+
+	verification, warnings, err = settings.NewPolicyVerification(
+		context.TODO(), "kcp-system", v1beta1.VerificationPolicyWarn)
+	require.NoError(t, err)
+	_, err = verification(&descriptor, nil)
+	assert.NoError(t, err)
+	require.Len(t, warnings.List(), 1)
+	assert.Contains(t, warnings.List()[0], "module kyma-project.io/module/sample:1.0.0 could not be verified")
+
+	verification, _, err = settings.NewPolicyVerification(
+		context.TODO(), "other-namespace", v1beta1.VerificationPolicyOff)
+	require.NoError(t, err)
+	_, err = verification(&descriptor, nil)
+	assert.NoError(t, err)
+}
@@ -2,47 +2,85 @@ package signature
 
 import (
 	"context"
-	"crypto/rsa"
+	"crypto"
 	"crypto/x509"
-	"encoding/pem"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
 	"strings"
+	"time"
 
 	v2 "github.com/gardener/component-spec/bindings-go/apis/v2"
-	"github.com/gardener/component-spec/bindings-go/apis/v2/signatures"
 	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
 	v1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	k8slabels "k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlLog "sigs.k8s.io/controller-runtime/pkg/log"
 )
 
 var (
 	ErrDecodePEMInSecret  = errors.New("unable to decode pem formatted block in key from secret")
 	ErrPublicKeyWrongType = errors.New("parsed public key is not correct type")
 	ErrNoSignatureFound   = errors.New("no signature was found")
+	ErrNoVerificationKey  = errors.New("neither a public key nor a CA bundle is configured")
+	ErrNoValidKey         = errors.New("no key of the signature is currently valid")
+	ErrInvalidKeyValidity = errors.New("validity period of the key is invalid")
 )
 
+// Key is one of the keys of a signature name. It is only used for verification within its validity period,
+// so that a new key can be distributed before and an old key retired after rotating the signing key.
+type Key struct {
+	*Verifier
+	// Name identifies the key in verification results, e.g. the name of the Secret it was read from.
+	Name      string
+	NotBefore *time.Time
+	NotAfter  *time.Time
+}
+
+// ValidAt returns true if the time lies within the validity period of the key.
+func (k *Key) ValidAt(now time.Time) bool {
+	return (k.NotBefore == nil || !now.Before(*k.NotBefore)) && (k.NotAfter == nil || !now.After(*k.NotAfter))
+}
+
+// MultiVerifier verifies a signature with the keys of its name, and succeeds if any of the currently valid keys
+// verifies it.
 type MultiVerifier struct {
-	verifiers map[string]signatures.Verifier
+	verifiers map[string][]Key
+	// Now returns the time the validity of keys is checked at, it defaults to time.Now.
+	Now func() time.Time
+}
+
+func NewMultiVerifier(keys map[string][]Key) *MultiVerifier {
+	for name := range keys {
+		sort.Slice(keys[name], func(i, j int) bool { return keys[name][i].Name < keys[name][j].Name })
+	}
+	return &MultiVerifier{verifiers: keys}
 }
 
 type VerificationSettings struct {
 	client.Client
-	PublicKeyFilePath   string
+	PublicKeyFilePath string
+	// CABundleFilePath points to the root certificates that certificate chains of signatures are validated against.
+	CABundleFilePath    string
 	ValidSignatureNames []string
 	EnableVerification  bool
 }
 
-type Verification func(descriptor *v2.ComponentDescriptor) error
+// Verification verifies the signatures of the descriptor and returns the name of the key that verified it.
+// The source is the raw descriptor the descriptor was decoded from, see VerifyDigest.
+type Verification func(descriptor *v2.ComponentDescriptor, source []byte) (string, error)
 
-var NoSignatureVerification Verification = func(descriptor *v2.ComponentDescriptor) error { return nil } //nolint:lll,gochecknoglobals
+var NoSignatureVerification Verification = func(*v2.ComponentDescriptor, []byte) (string, error) { return "", nil } //nolint:lll,gochecknoglobals
 
-func Verify(
-	descriptor *v2.ComponentDescriptor, signatureVerification Verification,
-) error {
-	if err := signatureVerification(descriptor); err != nil {
+// Verify verifies the signatures of the descriptor. The key that verified it is recorded
+// in the status of its ModuleTemplate, see VerificationSettings.VerifyTemplateWithKey.
+// Descriptors in the v3alpha1 schema are signed in their original form, so their digest is calculated
+// from the source the descriptor was decoded from.
+func Verify(descriptor *v2.ComponentDescriptor, source []byte, signatureVerification Verification) error {
+	if _, err := signatureVerification(descriptor, source); err != nil {
 		return fmt.Errorf("signature verification error, untrusted: %w", err)
 	}
 	return nil
@@ -54,52 +92,145 @@ func (settings *VerificationSettings) NewVerification(
 	if !settings.EnableVerification {
 		return NoSignatureVerification, nil
 	}
+	return settings.newVerification(ctx, namespace)
+}
 
-	var verifier signatures.Verifier
+func (settings *VerificationSettings) newVerification(
+	ctx context.Context, namespace string,
+) (Verification, error) {
+	var verifier *MultiVerifier
 	var err error
-	if settings.PublicKeyFilePath == "" {
-		verifier, err = CreateRSAVerifierFromSecrets(ctx, settings, settings.ValidSignatureNames, namespace)
+	if settings.PublicKeyFilePath == "" && settings.CABundleFilePath == "" {
+		verifier, err = CreateVerifierFromSecrets(ctx, settings, settings.ValidSignatureNames, namespace)
 	} else {
-		verifier, err = signatures.CreateRSAVerifierFromKeyFile(settings.PublicKeyFilePath)
+		verifier, err = settings.createVerifierFromFiles()
 	}
 	if err != nil {
 		return nil, fmt.Errorf("error occurred while initializing Signature Verifier: %w", err)
 	}
 
-	return func(descriptor *v2.ComponentDescriptor) error {
+	return func(descriptor *v2.ComponentDescriptor, source []byte) (string, error) {
 		for _, sig := range descriptor.Signatures {
 			for _, validName := range settings.ValidSignatureNames {
 				if sig.Name == validName {
-					if err := verifier.Verify(*descriptor, sig); err != nil {
-						return fmt.Errorf("error occurred during signature verification: %w", err)
+					key, err := verifier.VerifyWithKey(*descriptor, source, sig)
+					if err != nil {
+						return "", fmt.Errorf("error occurred during signature verification: %w", err)
 					}
-					return nil
+					return key, nil
 				}
 			}
 		}
-		return fmt.Errorf("descriptor contains invalid signature list: %w", ErrNoSignatureFound)
+		return "", fmt.Errorf("descriptor contains invalid signature list: %w", ErrNoSignatureFound)
 	}, nil
 }
 
-func CreateMultiRSAVerifier(publicKeys map[string]*rsa.PublicKey) (*MultiVerifier, error) {
-	verifiers := make(map[string]signatures.Verifier)
-	for signatureName, publicKey := range publicKeys {
-		var err error
-		verifiers[signatureName], err = signatures.CreateRSAVerifier(publicKey)
-		if err != nil {
-			return nil, err
-		}
+// createVerifierFromFiles uses the key files for all valid signature names, named after the files.
+func (settings *VerificationSettings) createVerifierFromFiles() (*MultiVerifier, error) {
+	verifier, err := CreateVerifierFromFiles(settings.PublicKeyFilePath, settings.CABundleFilePath)
+	if err != nil {
+		return nil, err
 	}
-	return &MultiVerifier{verifiers}, nil
+	name := filepath.Base(settings.PublicKeyFilePath)
+	if settings.PublicKeyFilePath == "" {
+		name = filepath.Base(settings.CABundleFilePath)
+	}
+	keys := make(map[string][]Key, len(settings.ValidSignatureNames))
+	for _, signatureName := range settings.ValidSignatureNames {
+		keys[signatureName] = []Key{{Verifier: verifier, Name: name}}
+	}
+	return NewMultiVerifier(keys), nil
+}
+
+// VerifyTemplate is a v1beta1.DescriptorValidation that verifies the signatures of a ModuleTemplate
+// with the keys available in its namespace. It does nothing if verification is disabled.
+func (settings *VerificationSettings) VerifyTemplate(
+	ctx context.Context, template *v1beta1.ModuleTemplate, descriptor *v2.ComponentDescriptor,
+) error {
+	_, err := settings.VerifyTemplateWithKey(ctx, template, descriptor)
+	return err
+}
+
+// VerifyTemplateWithKey verifies the signatures of a ModuleTemplate like VerifyTemplate
+// and returns the name of the key that verified them.
+func (settings *VerificationSettings) VerifyTemplateWithKey(
+	ctx context.Context, template *v1beta1.ModuleTemplate, descriptor *v2.ComponentDescriptor,
+) (string, error) {
+	verification, err := settings.NewVerification(ctx, template.GetNamespace())
+	if err != nil {
+		return "", err
+	}
+	key, err := verification(descriptor, template.Spec.OCMDescriptor.Raw)
+	if err != nil {
+		return "", fmt.Errorf("signature verification error, untrusted: %w", err)
+	}
+	return key, nil
 }
 
 func (v MultiVerifier) Verify(componentDescriptor v2.ComponentDescriptor, signature v2.Signature) error {
-	return v.verifiers[signature.Name].Verify(componentDescriptor, signature) //nolint:wrapcheck
+	_, err := v.VerifyWithKey(componentDescriptor, nil, signature)
+	return err
+}
+
+// VerifyWithKey checks the digest of the signature against the descriptor and its source, see VerifyDigest,
+// tries the currently valid keys of the signature name in the order of their names, and returns the name
+// of the first key that verifies the signature.
+func (v MultiVerifier) VerifyWithKey(
+	componentDescriptor v2.ComponentDescriptor, source []byte, signature v2.Signature,
+) (string, error) {
+	keys, ok := v.verifiers[signature.Name]
+	if !ok {
+		return "", fmt.Errorf("%w: no key for signature %s", ErrNoSignatureFound, signature.Name)
+	}
+	if err := VerifyDigest(&componentDescriptor, source, signature.Digest); err != nil {
+		return "", err
+	}
+	now := time.Now()
+	if v.Now != nil {
+		now = v.Now()
+	}
+
+	var failures []string
+	for _, key := range keys {
+		if !key.ValidAt(now) {
+			continue
+		}
+		if err := key.VerifySignature(signature); err != nil {
+			failures = append(failures, fmt.Sprintf("key %s: %s", key.Name, err.Error()))
+			continue
+		}
+		return key.Name, nil
+	}
+	if len(failures) == 0 {
+		return "", fmt.Errorf("%w: %s", ErrNoValidKey, signature.Name)
+	}
+	return "", fmt.Errorf("%w: %s", ErrSignatureInvalid, strings.Join(failures, "; "))
+}
+
+// CreateVerifierFromFiles creates a Verifier from a public key file and a file with the root certificates
+// that certificate chains are validated against, either of which may be empty.
+func CreateVerifierFromFiles(publicKeyFilePath, caBundleFilePath string) (*Verifier, error) {
+	var publicKey, caBundle []byte
+	var err error
+	if publicKeyFilePath != "" {
+		if publicKey, err = os.ReadFile(publicKeyFilePath); err != nil {
+			return nil, fmt.Errorf("unable to open public key file: %w", err)
+		}
+	}
+	if caBundleFilePath != "" {
+		if caBundle, err = os.ReadFile(caBundleFilePath); err != nil {
+			return nil, fmt.Errorf("unable to open CA bundle file: %w", err)
+		}
+	}
+	return createVerifier(publicKey, caBundle)
 }
 
-// CreateRSAVerifierFromSecrets creates an instance of RsaVerifier from a rsa public key file located as secret
-// in kubernetes. The key has to be in the PKIX, ASN.1 DER form, see x509.ParsePKIXPublicKey.
-func CreateRSAVerifierFromSecrets(
+// CreateVerifierFromSecrets creates a Key from each secret labelled with one of the signature names, so that
+// several keys can be valid for a signature name during key rotation. The optional key "key" holds an RSA,
+// ECDSA or Ed25519 public key in the PKIX, ASN.1 DER form, see x509.ParsePKIXPublicKey, and the optional
+// key "ca.crt" the root certificates of the signature. The validity period of a key is read from
+// the v1beta1.SignatureNotBeforeAnnotation and v1beta1.SignatureNotAfterAnnotation of its secret.
+func CreateVerifierFromSecrets(
 	ctx context.Context, k8sClient client.Client, validSignatureNames []string, namespace string,
 ) (*MultiVerifier, error) {
 	secretList := &v1.SecretList{}
@@ -118,23 +249,75 @@ func CreateRSAVerifierFromSecrets(
 		return nil, k8serrors.NewNotFound(gr, selector.String())
 	}
 
-	publicKeys := make(map[string]*rsa.PublicKey)
+	// a malformed secret must not prevent the verification with the remaining keys, e.g. during key rotation
+	keys := make(map[string][]Key)
+	var malformed error
 	for _, item := range secretList.Items {
-		publicKey := item.Data["key"]
-		block, _ := pem.Decode(publicKey)
-		if block == nil {
-			return nil, ErrDecodePEMInSecret
-		}
-		untypedKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+		key, err := createKeyFromSecret(item)
 		if err != nil {
-			return nil, fmt.Errorf("unable to parse public key: %w", err)
+			ctrlLog.FromContext(ctx).Error(err, "skipping malformed signature key secret",
+				"secret", client.ObjectKeyFromObject(&item).String())
+			malformed = err
+			continue
 		}
-		switch key := untypedKey.(type) {
-		case *rsa.PublicKey:
-			publicKeys[item.Labels[v1beta1.Signature]] = key
-		default:
-			return nil, fmt.Errorf("public key error: %w - type is %T", ErrPublicKeyWrongType, key)
+		signatureName := item.Labels[v1beta1.Signature]
+		keys[signatureName] = append(keys[signatureName], *key)
+	}
+	if len(keys) == 0 {
+		return nil, malformed
+	}
+	return NewMultiVerifier(keys), nil
+}
+
+func createKeyFromSecret(secret v1.Secret) (*Key, error) {
+	verifier, err := createVerifier(secret.Data["key"], secret.Data["ca.crt"])
+	if errors.Is(err, ErrDecodePEM) {
+		return nil, fmt.Errorf("%w %s", ErrDecodePEMInSecret, secret.GetName())
+	} else if err != nil {
+		return nil, fmt.Errorf("secret %s: %w", secret.GetName(), err)
+	}
+	key := &Key{Verifier: verifier, Name: secret.GetName()}
+	if key.NotBefore, err = parseValidity(secret, v1beta1.SignatureNotBeforeAnnotation); err != nil {
+		return nil, err
+	}
+	if key.NotAfter, err = parseValidity(secret, v1beta1.SignatureNotAfterAnnotation); err != nil {
+		return nil, err
+	}
+	if key.NotBefore != nil && key.NotAfter != nil && key.NotAfter.Before(*key.NotBefore) {
+		return nil, fmt.Errorf("%w: secret %s expires before it becomes valid", ErrInvalidKeyValidity, secret.GetName())
+	}
+	return key, nil
+}
+
+func parseValidity(secret v1.Secret, annotation string) (*time.Time, error) {
+	value, ok := secret.GetAnnotations()[annotation]
+	if !ok {
+		return nil, nil //nolint:nilnil // the key is valid without limit
+	}
+	validity, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: annotation %s of secret %s: %s",
+			ErrInvalidKeyValidity, annotation, secret.GetName(), err.Error())
+	}
+	return &validity, nil
+}
+
+func createVerifier(publicKeyPEM, caBundlePEM []byte) (*Verifier, error) {
+	if len(publicKeyPEM) == 0 && len(caBundlePEM) == 0 {
+		return nil, ErrNoVerificationKey
+	}
+	var publicKey crypto.PublicKey
+	var roots *x509.CertPool
+	var err error
+	if len(publicKeyPEM) > 0 {
+		if publicKey, err = ParsePublicKey(publicKeyPEM); err != nil {
+			return nil, err
+		}
+	}
+	if len(caBundlePEM) > 0 {
+		if roots, err = ParseCABundle(caBundlePEM); err != nil {
+			return nil, err
 		}
 	}
-	return CreateMultiRSAVerifier(publicKeys)
+	return &Verifier{PublicKey: publicKey, Roots: roots}, nil
 }
@@ -0,0 +1,462 @@
+package signature_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	v2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"github.com/gardener/component-spec/bindings-go/apis/v2/signatures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+	"github.com/kyma-project/lifecycle-manager/pkg/signature"
+)
+
+const signatureName = "kyma-module-signature"
+
+// descriptor returns the descriptor that signatures are created over.
+func descriptor() v2.ComponentDescriptor {
+	descriptor := v2.ComponentDescriptor{}
+	descriptor.Metadata.Version = v2.SchemaVersion
+	descriptor.Name, descriptor.Version = "kyma-project.io/module/sample", "1.0.0"
+	descriptor.Provider = v2.InternalProvider
+	descriptor.Resources = []v2.Resource{{
+		IdentityObjectMeta: v2.IdentityObjectMeta{Name: "sample-operator", Version: "1.0.0", Type: "helm-chart"},
+		Relation:           v2.LocalRelation,
+		Access: v2.NewUnstructuredType(v2.LocalOCIBlobType, map[string]interface{}{
+			"digest": "sha256:4d1293833dcc8514528c5b5a7bdd0b7c1e7b1fb2c1b1c37e5e4f0f6f1a1d2c3b",
+		}),
+		Digest: &v2.DigestSpec{
+			HashAlgorithm: signatures.SHA256, NormalisationAlgorithm: string(v2.OciArtifactDigestV1),
+			Value: "4d1293833dcc8514528c5b5a7bdd0b7c1e7b1fb2c1b1c37e5e4f0f6f1a1d2c3b",
+		},
+	}}
+	return descriptor
+}
+
+func digest(t *testing.T) []byte {
+	t.Helper()
+	spec, err := signatures.HashForComponentDescriptor(descriptor(), signatures.Hasher{
+		HashFunction: sha256.New(), AlgorithmName: signatures.SHA256,
+	})
+	require.NoError(t, err)
+	sum, err := hex.DecodeString(spec.Value)
+	require.NoError(t, err)
+	return sum
+}
+
+func sign(t *testing.T, signer crypto.Signer) []byte {
+	t.Helper()
+	opts := crypto.Hash(0)
+	if _, ok := signer.(ed25519.PrivateKey); !ok {
+		opts = crypto.SHA256
+	}
+	sig, err := signer.Sign(rand.Reader, digest(t), opts)
+	require.NoError(t, err)
+	return sig
+}
+
+func hexSignature(t *testing.T, algorithm, mediaType string, sig []byte) v2.Signature {
+	t.Helper()
+	return v2.Signature{
+		Name: signatureName,
+		Digest: v2.DigestSpec{
+			HashAlgorithm: signatures.SHA256, NormalisationAlgorithm: string(v2.JsonNormalisationV1),
+			Value: hex.EncodeToString(digest(t)),
+		},
+		Signature: v2.SignatureSpec{Algorithm: algorithm, MediaType: mediaType, Value: hex.EncodeToString(sig)},
+	}
+}
+
+func pemSignature(t *testing.T, algorithm string, sig []byte, chain ...*x509.Certificate) v2.Signature {
+	t.Helper()
+	value := &strings.Builder{}
+	_ = pem.Encode(value, &pem.Block{Type: v2.SignaturePEMBlockType, Bytes: sig})
+	for _, cert := range chain {
+		_ = pem.Encode(value, &pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+	}
+	sigWithChain := hexSignature(t, algorithm, v2.MediaTypePEM, nil)
+	sigWithChain.Signature.Value = value.String()
+	return sigWithChain
+}
+
+func TestVerifier_PublicKeys(t *testing.T) {
+	t.Parallel()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		key       crypto.Signer
+		signature func(t *testing.T) v2.Signature
+		expected  error
+	}{
+		{"rsa", rsaKey, func(t *testing.T) v2.Signature {
+			t.Helper()
+			return hexSignature(t, v2.RSAPKCS1v15, v2.MediaTypeRSASignature, sign(t, rsaKey))
+		}, nil},
+		{"ecdsa", ecdsaKey, func(t *testing.T) v2.Signature {
+			t.Helper()
+			return hexSignature(t, signature.ECDSA, signature.MediaTypeECDSASignature, sign(t, ecdsaKey))
+		}, nil},
+		{"ed25519 in pem form", ed25519Key, func(t *testing.T) v2.Signature {
+			t.Helper()
+			return pemSignature(t, signature.Ed25519, sign(t, ed25519Key))
+		}, nil},
+		{"signature of another key", ecdsaKey, func(t *testing.T) v2.Signature {
+			t.Helper()
+			other, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			require.NoError(t, err)
+			return hexSignature(t, signature.ECDSA, signature.MediaTypeECDSASignature, sign(t, other))
+		}, signature.ErrSignatureInvalid},
+		{"algorithm of another key type", ed25519Key, func(t *testing.T) v2.Signature {
+			t.Helper()
+			return hexSignature(t, v2.RSAPKCS1v15, v2.MediaTypeRSASignature, sign(t, ed25519Key))
+		}, signature.ErrSignatureAlgorithm},
+	}
+	for _, testCase := range tests {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			publicKey, err := x509.MarshalPKIXPublicKey(testCase.key.Public())
+			require.NoError(t, err)
+			verifier, err := signature.CreateVerifierFromFiles(
+				writeFile(t, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey})), "")
+			require.NoError(t, err)
+
+			err = verifier.Verify(descriptor(), testCase.signature(t))
+			if testCase.expected == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, testCase.expected)
+			}
+		})
+	}
+}
+
+//nolint:funlen
+func TestVerifier_CertificateChain(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	root, rootKey := certificate(t, nil, nil, &x509.Certificate{
+		Subject: pkix.Name{CommonName: "Corporate Root CA"}, IsCA: true, BasicConstraintsValid: true,
+		KeyUsage: x509.KeyUsageCertSign, NotBefore: now.Add(-time.Hour), NotAfter: now.Add(time.Hour),
+	})
+	intermediate, intermediateKey := certificate(t, root, rootKey, &x509.Certificate{
+		Subject: pkix.Name{CommonName: "Corporate Signing CA"}, IsCA: true, BasicConstraintsValid: true,
+		KeyUsage: x509.KeyUsageCertSign, NotBefore: now.Add(-time.Hour), NotAfter: now.Add(time.Hour),
+	})
+	leaf := func(usage x509.KeyUsage, extUsage x509.ExtKeyUsage, notAfter time.Time) (*x509.Certificate, crypto.Signer) {
+		return certificate(t, intermediate, intermediateKey, &x509.Certificate{
+			Subject: pkix.Name{CommonName: signatureName}, KeyUsage: usage, ExtKeyUsage: []x509.ExtKeyUsage{extUsage},
+			NotBefore: now.Add(-time.Hour), NotAfter: notAfter,
+		})
+	}
+	untrustedRoot, untrustedKey := certificate(t, nil, nil, &x509.Certificate{
+		Subject: pkix.Name{CommonName: "Untrusted CA"}, IsCA: true, BasicConstraintsValid: true,
+		KeyUsage: x509.KeyUsageCertSign, NotBefore: now.Add(-time.Hour), NotAfter: now.Add(time.Hour),
+	})
+
+	tests := []struct {
+		name      string
+		signature func(t *testing.T) v2.Signature
+		expected  error
+	}{
+		{"valid chain", func(t *testing.T) v2.Signature {
+			t.Helper()
+			cert, key := leaf(x509.KeyUsageDigitalSignature, x509.ExtKeyUsageCodeSigning, now.Add(time.Hour))
+			return pemSignature(t, signature.ECDSA, sign(t, key), cert, intermediate)
+		}, nil},
+		{"expired certificate", func(t *testing.T) v2.Signature {
+			t.Helper()
+			cert, key := leaf(x509.KeyUsageDigitalSignature, x509.ExtKeyUsageCodeSigning, now.Add(-time.Minute))
+			return pemSignature(t, signature.ECDSA, sign(t, key), cert, intermediate)
+		}, signature.ErrCertificateUntrusted},
+		{"certificate for server authentication", func(t *testing.T) v2.Signature {
+			t.Helper()
+			cert, key := leaf(x509.KeyUsageDigitalSignature, x509.ExtKeyUsageServerAuth, now.Add(time.Hour))
+			return pemSignature(t, signature.ECDSA, sign(t, key), cert, intermediate)
+		}, signature.ErrCertificateUntrusted},
+		{"certificate for key encipherment", func(t *testing.T) v2.Signature {
+			t.Helper()
+			cert, key := leaf(x509.KeyUsageKeyEncipherment, x509.ExtKeyUsageCodeSigning, now.Add(time.Hour))
+			return pemSignature(t, signature.ECDSA, sign(t, key), cert, intermediate)
+		}, signature.ErrCertificateKeyUsage},
+		{"missing intermediate", func(t *testing.T) v2.Signature {
+			t.Helper()
+			cert, key := leaf(x509.KeyUsageDigitalSignature, x509.ExtKeyUsageCodeSigning, now.Add(time.Hour))
+			return pemSignature(t, signature.ECDSA, sign(t, key), cert)
+		}, signature.ErrCertificateUntrusted},
+		{"untrusted root", func(t *testing.T) v2.Signature {
+			t.Helper()
+			cert, key := certificate(t, untrustedRoot, untrustedKey, &x509.Certificate{
+				Subject: pkix.Name{CommonName: signatureName}, KeyUsage: x509.KeyUsageDigitalSignature,
+				NotBefore: now.Add(-time.Hour), NotAfter: now.Add(time.Hour),
+			})
+			return pemSignature(t, signature.ECDSA, sign(t, key), cert)
+		}, signature.ErrCertificateUntrusted},
+		{"certificate of another signature name", func(t *testing.T) v2.Signature {
+			t.Helper()
+			cert, key := certificate(t, intermediate, intermediateKey, &x509.Certificate{
+				Subject: pkix.Name{CommonName: "other-signature"}, KeyUsage: x509.KeyUsageDigitalSignature,
+				ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+				NotBefore:   now.Add(-time.Hour), NotAfter: now.Add(time.Hour),
+			})
+			return pemSignature(t, signature.ECDSA, sign(t, key), cert, intermediate)
+		}, signature.ErrCertificateIdentity},
+		{"signature without certificate", func(t *testing.T) v2.Signature {
+			t.Helper()
+			_, key := leaf(x509.KeyUsageDigitalSignature, x509.ExtKeyUsageCodeSigning, now.Add(time.Hour))
+			return pemSignature(t, signature.ECDSA, sign(t, key))
+		}, signature.ErrNoPublicKey},
+	}
+
+	verifier, err := signature.CreateVerifierFromFiles(
+		"", writeFile(t, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: root.Raw})))
+	require.NoError(t, err)
+	for _, testCase := range tests {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			err := verifier.Verify(descriptor(), testCase.signature(t))
+			if testCase.expected == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestVerifier_ModifiedDescriptor(t *testing.T) {
+	t.Parallel()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	k8sClient := fake.NewClientBuilder().WithObjects(keySecret(t, "key", key, "", "")).Build()
+	verifier, err := signature.CreateVerifierFromSecrets(context.TODO(), k8sClient, []string{signatureName}, "kcp-system")
+	require.NoError(t, err)
+	signed := hexSignature(t, signature.ECDSA, signature.MediaTypeECDSASignature, sign(t, key))
+
+	_, err = verifier.VerifyWithKey(descriptor(), nil, signed)
+	require.NoError(t, err)
+
+	modified := descriptor()
+	modified.Resources[0].Digest.Value = "0000000000000000000000000000000000000000000000000000000000000000"
+	_, err = verifier.VerifyWithKey(modified, nil, signed)
+	assert.ErrorIs(t, err, signature.ErrDigestMismatch)
+	assert.ErrorIs(t, verifier.Verify(modified, signed), signature.ErrDigestMismatch)
+}
+
+func TestParsePublicKey_UnsupportedPEM(t *testing.T) {
+	t.Parallel()
+	_, err := signature.ParsePublicKey([]byte("not a key"))
+	assert.ErrorIs(t, err, signature.ErrDecodePEM)
+}
+
+// certificate creates a certificate with an ECDSA key, which is self-signed if no parent is given.
+func certificate(
+	t *testing.T, parent *x509.Certificate, parentKey crypto.Signer, template *x509.Certificate,
+) (*x509.Certificate, crypto.Signer) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+	template.SerialNumber = serial
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	raw, err := x509.CreateCertificate(rand.Reader, template, parent, key.Public(), parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(raw)
+	require.NoError(t, err)
+	return cert, key
+}
+
+func writeFile(t *testing.T, content []byte) string {
+	t.Helper()
+	file, err := os.CreateTemp(t.TempDir(), "verification")
+	require.NoError(t, err)
+	_, err = file.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+	return file.Name()
+}
+
+//nolint:funlen
+func TestCreateVerifierFromSecrets_KeyRotation(t *testing.T) {
+	t.Parallel()
+	now := time.Now().UTC().Truncate(time.Second)
+	oldKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	newKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	retiredKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	secrets := []client.Object{
+		keySecret(t, "key-2022", retiredKey, "", now.Add(-time.Hour).Format(time.RFC3339)),
+		keySecret(t, "key-2023", oldKey, now.Add(-time.Hour).Format(time.RFC3339), now.Add(time.Hour).Format(time.RFC3339)),
+		keySecret(t, "key-2024", newKey, now.Add(-time.Minute).Format(time.RFC3339), ""),
+	}
+	k8sClient := fake.NewClientBuilder().WithObjects(secrets...).Build()
+	verifier, err := signature.CreateVerifierFromSecrets(context.TODO(), k8sClient, []string{signatureName}, "kcp-system")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		key         crypto.Signer
+		at          time.Time
+		expectedKey string
+		expected    error
+	}{
+		{"signed with the old key during rotation", oldKey, now, "key-2023", nil},
+		{"signed with the new key during rotation", newKey, now, "key-2024", nil},
+		{"signed with the old key after rotation", oldKey, now.Add(2 * time.Hour), "", signature.ErrSignatureInvalid},
+		{"signed with a retired key", retiredKey, now, "", signature.ErrSignatureInvalid},
+		{"signed with the retired key while it was valid", retiredKey, now.Add(-2 * time.Hour), "key-2022", nil},
+	}
+	for _, testCase := range tests {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			verifierAt := *verifier
+			verifierAt.Now = func() time.Time { return testCase.at }
+			key, err := verifierAt.VerifyWithKey(descriptor(), nil,
+				hexSignature(t, signature.ECDSA, signature.MediaTypeECDSASignature, sign(t, testCase.key)))
+			if testCase.expected == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, testCase.expected)
+			}
+			assert.Equal(t, testCase.expectedKey, key)
+		})
+	}
+}
+
+func TestCreateVerifierFromSecrets_InvalidValidity(t *testing.T) {
+	t.Parallel()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	k8sClient := fake.NewClientBuilder().WithObjects(keySecret(t, "key", key, "tomorrow", "")).Build()
+	_, err = signature.CreateVerifierFromSecrets(context.TODO(), k8sClient, []string{signatureName}, "kcp-system")
+	assert.ErrorIs(t, err, signature.ErrInvalidKeyValidity)
+}
+
+func TestCreateVerifierFromSecrets_MalformedSecret(t *testing.T) {
+	t.Parallel()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	malformed := keySecret(t, "key-malformed", key, "", "")
+	malformed.Data["key"] = []byte("not a key")
+	k8sClient := fake.NewClientBuilder().WithObjects(malformed, keySecret(t, "key", key, "", "")).Build()
+
+	verifier, err := signature.CreateVerifierFromSecrets(context.TODO(), k8sClient, []string{signatureName}, "kcp-system")
+	require.NoError(t, err)
+	verifiedBy, err := verifier.VerifyWithKey(descriptor(), nil,
+		hexSignature(t, signature.ECDSA, signature.MediaTypeECDSASignature, sign(t, key)))
+	require.NoError(t, err)
+	assert.Equal(t, "key", verifiedBy)
+
+	k8sClient = fake.NewClientBuilder().WithObjects(malformed).Build()
+	_, err = signature.CreateVerifierFromSecrets(context.TODO(), k8sClient, []string{signatureName}, "kcp-system")
+	assert.ErrorIs(t, err, signature.ErrDecodePEMInSecret)
+}
+
+func TestVerificationSettings_VerifyTemplate(t *testing.T) {
+	t.Parallel()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	other, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	k8sClient := fake.NewClientBuilder().WithObjects(keySecret(t, "key", key, "", "")).Build()
+
+	tests := []struct {
+		name       string
+		enabled    bool
+		signatures func(t *testing.T) []v2.Signature
+		expected   error
+	}{
+		{"signed with the key", true, func(t *testing.T) []v2.Signature {
+			t.Helper()
+			return []v2.Signature{hexSignature(t, signature.ECDSA, signature.MediaTypeECDSASignature, sign(t, key))}
+		}, nil},
+		{"signed with another key", true, func(t *testing.T) []v2.Signature {
+			t.Helper()
+			return []v2.Signature{hexSignature(t, signature.ECDSA, signature.MediaTypeECDSASignature, sign(t, other))}
+		}, signature.ErrSignatureInvalid},
+		{"not signed", true, func(t *testing.T) []v2.Signature {
+			t.Helper()
+			return nil
+		}, signature.ErrNoSignatureFound},
+		{"not signed without verification", false, func(t *testing.T) []v2.Signature {
+			t.Helper()
+			return nil
+		}, nil},
+	}
+	for _, testCase := range tests {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			settings := &signature.VerificationSettings{
+				Client:              k8sClient,
+				ValidSignatureNames: []string{signatureName},
+				EnableVerification:  testCase.enabled,
+			}
+			template := &v1beta1.ModuleTemplate{ObjectMeta: metav1.ObjectMeta{Name: "sample", Namespace: "kcp-system"}}
+			signed := descriptor()
+			signed.Signatures = testCase.signatures(t)
+
+			err := settings.VerifyTemplate(context.TODO(), template, &signed)
+			if testCase.expected == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, testCase.expected)
+			}
+		})
+	}
+}
+
+func keySecret(t *testing.T, name string, key crypto.Signer, notBefore, notAfter string) *corev1.Secret {
+	t.Helper()
+	publicKey, err := x509.MarshalPKIXPublicKey(key.Public())
+	require.NoError(t, err)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name, Namespace: "kcp-system", Labels: map[string]string{v1beta1.Signature: signatureName},
+			Annotations: map[string]string{},
+		},
+		Data: map[string][]byte{"key": pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey})},
+	}
+	if notBefore != "" {
+		secret.Annotations[v1beta1.SignatureNotBeforeAnnotation] = notBefore
+	}
+	if notAfter != "" {
+		secret.Annotations[v1beta1.SignatureNotAfterAnnotation] = notAfter
+	}
+	return secret
+}
@@ -9,6 +9,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+	"github.com/kyma-project/lifecycle-manager/pkg/metrics"
 )
 
 type KymaHelper struct {
@@ -38,6 +39,8 @@ func (k *KymaHelper) UpdateStatusForExistingModules(ctx context.Context,
 	default:
 	}
 
+	kyma.UpdateReadyCondition()
+
 	kyma.Status.LastOperation = v1beta1.LastOperation{
 		Operation:      message,
 		LastUpdateTime: metav1.NewTime(time.Now()),
@@ -47,5 +50,7 @@ func (k *KymaHelper) UpdateStatusForExistingModules(ctx context.Context,
 		return fmt.Errorf("status could not be updated: %w", err)
 	}
 
+	metrics.UpdateKymaStates(kyma)
+
 	return nil
 }
@@ -0,0 +1,53 @@
+package watch
+
+import (
+	"context"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+type RolloutChangeHandler struct {
+	client.Reader
+}
+
+func NewRolloutChangeHandler(handlerClient ChangeHandlerClient) *RolloutChangeHandler {
+	return &RolloutChangeHandler{Reader: handlerClient}
+}
+
+// Watch schedules all Kymas that run the Module staged by a ModuleRollout for reconciliation,
+// so that they pick up the new version as soon as their wave is released.
+func (h *RolloutChangeHandler) Watch(ctx context.Context) handler.MapFunc {
+	return func(o client.Object) []reconcile.Request {
+		requests := make([]reconcile.Request, 0)
+		rollout, ok := o.(*v1beta1.ModuleRollout)
+		if !ok {
+			return requests
+		}
+
+		kymas := &v1beta1.KymaList{}
+		if err := h.List(ctx, kymas, client.InNamespace(rollout.GetNamespace())); err != nil {
+			return requests
+		}
+
+		logger := log.FromContext(ctx)
+
+		for _, kyma := range kymas.Items {
+			if !requeueKyma(kyma, rollout.Spec.Module, rollout.Spec.Channel) {
+				continue
+			}
+			kymaName := types.NamespacedName{Namespace: kyma.GetNamespace(), Name: kyma.GetName()}
+			logger.WithValues("rollout", client.ObjectKeyFromObject(rollout).String(),
+				"kyma", kymaName.String()).Info(
+				"Kyma CR instance is scheduled for reconciliation because a relevant ModuleRollout changed",
+			)
+			requests = append(requests, reconcile.Request{NamespacedName: kymaName})
+		}
+
+		return requests
+	}
+}
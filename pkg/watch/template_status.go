@@ -0,0 +1,71 @@
+package watch
+
+import (
+	"context"
+
+	"github.com/kyma-project/lifecycle-manager/api/v1beta1"
+	"github.com/kyma-project/lifecycle-manager/pkg/index"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+type TemplateStatusHandler struct {
+	client.Reader
+}
+
+func NewTemplateStatusHandler(reader client.Reader) *TemplateStatusHandler {
+	return &TemplateStatusHandler{Reader: reader}
+}
+
+// WatchKymas schedules the ModuleTemplates resolved by a Kyma for reconciliation, so that their status
+// reflects the Kymas using them. On updates, the templates of the previous and the new Kyma are scheduled.
+func (h *TemplateStatusHandler) WatchKymas() handler.MapFunc {
+	return func(o client.Object) []reconcile.Request {
+		requests := make([]reconcile.Request, 0)
+		kyma, ok := o.(*v1beta1.Kyma)
+		if !ok {
+			return requests
+		}
+		for _, module := range kyma.Status.Modules {
+			if module.Template.Name == "" {
+				continue
+			}
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{
+				Namespace: module.Template.Namespace, Name: module.Template.Name,
+			}})
+		}
+		return requests
+	}
+}
+
+// WatchRelatedTemplates schedules all ModuleTemplates of the same Module for reconciliation,
+// so that they notice when a newer template is published in their channel.
+func (h *TemplateStatusHandler) WatchRelatedTemplates(ctx context.Context) handler.MapFunc {
+	return func(o client.Object) []reconcile.Request {
+		requests := make([]reconcile.Request, 0)
+		template, ok := o.(*v1beta1.ModuleTemplate)
+		if !ok {
+			return requests
+		}
+		descriptor, err := template.Spec.GetUnsafeDescriptor()
+		if err != nil {
+			return requests
+		}
+
+		templates := &v1beta1.ModuleTemplateList{}
+		if err := h.List(ctx, templates, index.TemplateFQDNField.WithValue(descriptor.GetName())); err != nil {
+			return requests
+		}
+		for _, related := range templates.Items {
+			if related.GetName() == template.GetName() && related.GetNamespace() == template.GetNamespace() {
+				continue
+			}
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{
+				Namespace: related.GetNamespace(), Name: related.GetName(),
+			}})
+		}
+		return requests
+	}
+}